@@ -7,17 +7,65 @@ type Publisher[T any] interface {
 	Subscribe() <-chan T
 }
 
+// Tick describes a single clock tick.
+type Tick struct {
+	// Seq is the 1-based sequence number of the tick.
+	Seq uint64
+	// Scheduled is the time the tick was due on the clock's timeline.
+	// For simulated timelines (ScaledClock) this is simulated time.
+	Scheduled time.Time
+	// Actual is the wall-clock time the tick was emitted.
+	Actual time.Time
+}
+
+// State describes the lifecycle phase of a Clock.
+type State uint32
+
+const (
+	// StateIdle is the initial state before Start is called.
+	StateIdle State = iota
+	// StateRunning indicates the clock is emitting ticks.
+	StateRunning
+	// StatePaused indicates the clock is silent but subscribers stay attached.
+	StatePaused
+	// StateStopped is terminal; the tick channel has been closed.
+	StateStopped
+)
+
+// String returns the lowercase state name.
+func (s State) String() string {
+	switch s {
+	case StateIdle:
+		return "idle"
+	case StateRunning:
+		return "running"
+	case StatePaused:
+		return "paused"
+	case StateStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
 // ClockStats contains observable metrics for a Clock.
 type ClockStats struct {
-	TickCount uint64
+	TickCount uint64 // ticks delivered to the subscriber
 	IsRunning bool
+	State     State
 	Interval  time.Duration
 }
 
 // Clock provides timing signals for value updates.
+//
+// Lifecycle: idle → running ⇄ paused → stopped.
+// Start and Resume are no-ops on a running clock, Pause is a no-op on a
+// paused one, and Stop is terminal and safe to call multiple times.
 type Clock interface {
-	Publisher[struct{}]
+	Publisher[Tick]
 	Start()
+	Pause()
+	Resume()
 	Stop()
 	Stats() ClockStats
 }
@@ -0,0 +1,78 @@
+package clock
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// core holds the tick channel and lifecycle state shared by all clocks.
+// Embedding clocks drive their own timing and use emit to publish ticks.
+type core struct {
+	tickChan  chan Tick
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	tickCount atomic.Uint64
+
+	// Lifecycle (protected by mu)
+	mu    sync.Mutex
+	state State
+}
+
+func newCore() core {
+	return core{
+		tickChan: make(chan Tick),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Subscribe returns the channel that receives tick events.
+func (c *core) Subscribe() <-chan Tick {
+	return c.tickChan
+}
+
+// State returns the current lifecycle state.
+func (c *core) State() State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+// emit publishes a single tick that was due at scheduled and counts it
+// once delivered, so a tick still waiting for a receiver is not counted.
+// Must only be called from the run goroutine.
+// Returns false if the clock was stopped while waiting for a receiver.
+func (c *core) emit(scheduled time.Time) bool {
+	tick := Tick{
+		Seq:       c.tickCount.Load() + 1,
+		Scheduled: scheduled,
+		Actual:    time.Now(),
+	}
+	select {
+	case c.tickChan <- tick:
+		c.tickCount.Add(1)
+		return true
+	case <-c.stop:
+		return false
+	}
+}
+
+// shutdown transitions to StateStopped, calls halt with c.mu held to release
+// timing resources, waits for the run goroutine and closes the tick channel.
+// Safe to call multiple times.
+func (c *core) shutdown(halt func()) {
+	c.mu.Lock()
+	if c.state == StateStopped {
+		c.mu.Unlock()
+		return
+	}
+	if c.state != StateIdle {
+		halt()
+	}
+	c.state = StateStopped
+	c.mu.Unlock()
+
+	close(c.stop)
+	c.wg.Wait()
+	close(c.tickChan)
+}
@@ -0,0 +1,210 @@
+package clock
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed cron expression.
+// Each field is a bitmask of the permitted values.
+type cronSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+
+	// Day matching follows cron semantics: when both day-of-month and
+	// day-of-week are restricted, a day matches if either field matches.
+	domStar, dowStar bool
+
+	loc *time.Location
+}
+
+// cronField describes the value range of a single cron field.
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var (
+	secondField = cronField{"second", 0, 59}
+	minuteField = cronField{"minute", 0, 59}
+	hourField   = cronField{"hour", 0, 23}
+	domField    = cronField{"day-of-month", 1, 31}
+	monthField  = cronField{"month", 1, 12}
+	dowField    = cronField{"day-of-week", 0, 7}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// ParseCron parses a cron expression into a Schedule evaluated in local time.
+//
+// Accepted forms:
+//
+//	minute hour day-of-month month day-of-week          (5 fields)
+//	second minute hour day-of-month month day-of-week   (6 fields)
+//	@yearly, @monthly, @weekly, @daily, @hourly
+//
+// Each field supports "*", single values, ranges "a-b", steps "*/n" and
+// "a-b/n", and comma-separated lists. Day-of-week accepts 0-7, where both
+// 0 and 7 are Sunday.
+func ParseCron(expr string) (Schedule, error) {
+	return ParseCronIn(expr, time.Local)
+}
+
+// ParseCronIn is like ParseCron but evaluates the schedule in loc.
+func ParseCronIn(expr string, loc *time.Location) (Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if desc, ok := cronDescriptors[spec]; ok {
+		spec = desc
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("cron %q: expected 5 or 6 fields, got %d", expr, len(fields))
+	}
+
+	s := &cronSchedule{loc: loc}
+	targets := []struct {
+		mask  *uint64
+		field cronField
+	}{
+		{&s.second, secondField},
+		{&s.minute, minuteField},
+		{&s.hour, hourField},
+		{&s.dom, domField},
+		{&s.month, monthField},
+		{&s.dow, dowField},
+	}
+	for i, target := range targets {
+		mask, err := parseCronField(fields[i], target.field)
+		if err != nil {
+			return nil, fmt.Errorf("cron %q: %w", expr, err)
+		}
+		*target.mask = mask
+	}
+
+	// Sunday may be written as 0 or 7
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = strings.HasPrefix(fields[3], "*")
+	s.dowStar = strings.HasPrefix(fields[5], "*")
+
+	return s, nil
+}
+
+// parseCronField converts a comma-separated field into a bitmask.
+func parseCronField(expr string, f cronField) (uint64, error) {
+	var mask uint64
+	for part := range strings.SplitSeq(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepExpr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("%s: invalid step %q", f.name, stepExpr)
+			}
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+		if rangeExpr != "*" {
+			loExpr, hiExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if lo, err = parseCronValue(loExpr, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseCronValue(hiExpr, f); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				// "a/n" means "a-max/n"
+				hi = f.max
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("%s: invalid range %q", f.name, rangeExpr)
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			mask |= 1 << v
+		}
+	}
+	return mask, nil
+}
+
+func parseCronValue(expr string, f cronField) (int, error) {
+	v, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid value %q", f.name, expr)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s: value %d out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first matching time strictly after t.
+// Returns the zero time if nothing matches within five years
+// (e.g. "0 0 30 2 *").
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	orig := t.Location()
+	t = t.In(s.loc).Truncate(time.Second).Add(time.Second)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		if s.second&(1<<uint(t.Second())) == 0 {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t.In(orig)
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dowMatch
+	case s.dowStar:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
@@ -1,46 +1,179 @@
 package clock
 
-import (
-	"sync"
-	"sync/atomic"
-	"time"
-)
+import "time"
 
 // PeriodicClock generates ticks at fixed intervals.
+// The interval can be changed at runtime via SetInterval or RampInterval.
 type PeriodicClock struct {
-	interval  time.Duration
-	ticker    *time.Ticker
-	tickChan  chan struct{}
-	stop      chan struct{}
-	wg        sync.WaitGroup
-	tickCount atomic.Uint64
-	running   atomic.Bool
+	core
+	ticker *time.Ticker
+
+	// Timing (protected by core.mu)
+	interval time.Duration
+	ramp     *ramp
+}
+
+// ramp describes a linear interval change over wall-clock time.
+type ramp struct {
+	from, to time.Duration
+	start    time.Time
+	duration time.Duration
+}
+
+// at returns the interpolated interval at time now and whether the ramp is complete.
+func (r *ramp) at(now time.Time) (time.Duration, bool) {
+	elapsed := now.Sub(r.start)
+	if elapsed >= r.duration {
+		return r.to, true
+	}
+	delta := float64(r.to-r.from) * float64(elapsed) / float64(r.duration)
+	return r.from + time.Duration(delta), false
 }
 
 // NewPeriodicClock creates a new clock that ticks at the specified interval.
+// Panics if interval is not positive.
 func NewPeriodicClock(interval time.Duration) *PeriodicClock {
+	if interval <= 0 {
+		panic("non-positive interval for NewPeriodicClock")
+	}
 	return &PeriodicClock{
+		core:     newCore(),
 		interval: interval,
-		tickChan: make(chan struct{}),
-		stop:     make(chan struct{}),
 	}
 }
 
 // Start begins generating ticks.
+// Calling Start on a running clock is a no-op, on a paused clock it resumes.
+// Panics if the clock has been stopped.
 func (c *PeriodicClock) Start() {
-	c.ticker = time.NewTicker(c.interval)
-	c.running.Store(true)
-	c.wg.Go(c.run)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case StateIdle:
+		c.ticker = time.NewTicker(c.interval)
+		c.state = StateRunning
+		c.wg.Go(c.run)
+	case StatePaused:
+		c.resumeLocked()
+	case StateStopped:
+		panic("cannot start stopped clock")
+	}
+}
+
+// Pause suspends tick generation without closing the tick channel.
+// Subscribers stay attached and receive ticks again after Resume.
+// No-op unless the clock is running.
+func (c *PeriodicClock) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return
+	}
+	c.ticker.Stop()
+	c.state = StatePaused
+}
+
+// Resume restarts tick generation after Pause.
+// The first tick arrives one full interval after Resume.
+// No-op unless the clock is paused.
+func (c *PeriodicClock) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StatePaused {
+		return
+	}
+	c.resumeLocked()
+}
+
+// resumeLocked must be called with c.mu held.
+func (c *PeriodicClock) resumeLocked() {
+	c.ticker.Reset(c.interval)
+	c.state = StateRunning
+}
+
+// SetInterval changes the tick interval immediately and cancels any active ramp.
+// On a running clock the next tick arrives one new interval after the call.
+// Panics if interval is not positive.
+func (c *PeriodicClock) SetInterval(interval time.Duration) {
+	if interval <= 0 {
+		panic("non-positive interval for SetInterval")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ramp = nil
+	c.setIntervalLocked(interval)
+}
+
+// RampInterval changes the tick interval linearly from its current value to
+// target over the given wall-clock duration. The interval is re-evaluated on
+// every tick, so the ramp advances in tick-sized steps. Time spent paused
+// counts towards the ramp duration.
+// A non-positive duration is equivalent to SetInterval(target).
+// Panics if target is not positive.
+func (c *PeriodicClock) RampInterval(target, over time.Duration) {
+	if target <= 0 {
+		panic("non-positive interval for RampInterval")
+	}
+	if over <= 0 {
+		c.SetInterval(target)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ramp = &ramp{
+		from:     c.interval,
+		to:       target,
+		start:    time.Now(),
+		duration: over,
+	}
+}
+
+// setIntervalLocked must be called with c.mu held.
+func (c *PeriodicClock) setIntervalLocked(interval time.Duration) {
+	if interval == c.interval {
+		return
+	}
+	c.interval = interval
+	if c.state == StateRunning {
+		c.ticker.Reset(interval)
+	}
+}
+
+// advanceRamp applies the current ramp position.
+// Reports whether the tick should be delivered (false if the clock is not running).
+func (c *PeriodicClock) advanceRamp(now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return false
+	}
+	if c.ramp != nil {
+		interval, done := c.ramp.at(now)
+		if done {
+			c.ramp = nil
+		}
+		c.setIntervalLocked(max(interval, time.Nanosecond))
+	}
+	return true
 }
 
 func (c *PeriodicClock) run() {
 	for {
 		select {
-		case <-c.ticker.C:
-			c.tickCount.Add(1)
-			select {
-			case c.tickChan <- struct{}{}:
-			case <-c.stop:
+		case now := <-c.ticker.C:
+			// Drop ticks that raced with Pause
+			if !c.advanceRamp(now) {
+				continue
+			}
+			if !c.emit(now) {
 				return
 			}
 		case <-c.stop:
@@ -50,26 +183,21 @@ func (c *PeriodicClock) run() {
 }
 
 // Stop stops the clock and closes the tick channel.
+// Stopping is terminal. Safe to call multiple times.
 func (c *PeriodicClock) Stop() {
-	if c.ticker != nil {
-		c.ticker.Stop()
-	}
-	c.running.Store(false)
-	close(c.stop)
-	c.wg.Wait()
-	close(c.tickChan)
-}
-
-// Subscribe returns the channel that receives tick events.
-func (c *PeriodicClock) Subscribe() <-chan struct{} {
-	return c.tickChan
+	c.shutdown(func() { c.ticker.Stop() })
 }
 
 // Stats returns current clock metrics.
 func (c *PeriodicClock) Stats() ClockStats {
+	c.mu.Lock()
+	state, interval := c.state, c.interval
+	c.mu.Unlock()
+
 	return ClockStats{
 		TickCount: c.tickCount.Load(),
-		IsRunning: c.running.Load(),
-		Interval:  c.interval,
+		IsRunning: state == StateRunning,
+		State:     state,
+		Interval:  interval,
 	}
 }
@@ -0,0 +1,89 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+)
+
+// TestPeriodicClock_Lifecycle walks the clock through every state transition.
+func TestPeriodicClock_Lifecycle(t *testing.T) {
+	clk := clock.NewPeriodicClock(5 * time.Millisecond)
+	ticks := clk.Subscribe()
+
+	if got := clk.Stats().State; got != clock.StateIdle {
+		t.Fatalf("initial state = %v, want %v", got, clock.StateIdle)
+	}
+
+	clk.Start()
+	clk.Start() // no-op, must not leak a second ticker
+	<-ticks
+
+	clk.Pause()
+	if got := clk.Stats().State; got != clock.StatePaused {
+		t.Fatalf("state after Pause = %v, want %v", got, clock.StatePaused)
+	}
+
+	// A tick in flight when Pause was called stays blocked until received
+	// and is only counted once delivered
+	paused := clk.Stats().TickCount
+	time.Sleep(30 * time.Millisecond)
+	if got := clk.Stats().TickCount; got != paused {
+		t.Errorf("tick count advanced while paused: %d -> %d", paused, got)
+	}
+
+	clk.Resume()
+	<-ticks
+	<-ticks // the first may have been in flight when Pause was called
+	if got := clk.Stats(); !got.IsRunning || got.TickCount <= paused {
+		t.Errorf("stats after Resume = %+v, want running with ticks > %d", got, paused)
+	}
+
+	clk.Stop()
+	clk.Stop() // idempotent
+
+	if _, ok := <-ticks; ok {
+		t.Error("tick channel still open after Stop")
+	}
+	if got := clk.Stats().State; got != clock.StateStopped {
+		t.Errorf("state after Stop = %v, want %v", got, clock.StateStopped)
+	}
+}
+
+// TestPeriodicClock_StartAfterStop verifies stopping is terminal.
+func TestPeriodicClock_StartAfterStop(t *testing.T) {
+	clk := clock.NewPeriodicClock(time.Millisecond)
+	clk.Start()
+	clk.Stop()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Start after Stop did not panic")
+		}
+	}()
+	clk.Start()
+}
+
+// TestPeriodicClock_AdjustInterval verifies SetInterval and RampInterval are reflected in Stats.
+func TestPeriodicClock_AdjustInterval(t *testing.T) {
+	clk := clock.NewPeriodicClock(10 * time.Millisecond)
+	ticks := clk.Subscribe()
+	clk.Start()
+	defer clk.Stop()
+
+	clk.SetInterval(2 * time.Millisecond)
+	if got := clk.Stats().Interval; got != 2*time.Millisecond {
+		t.Fatalf("interval after SetInterval = %v, want 2ms", got)
+	}
+
+	clk.RampInterval(time.Millisecond, 20*time.Millisecond)
+	deadline := time.After(time.Second)
+	for clk.Stats().Interval != time.Millisecond {
+		select {
+		case <-ticks:
+		case <-deadline:
+			t.Fatalf("ramp did not reach target, interval = %v", clk.Stats().Interval)
+		}
+	}
+}
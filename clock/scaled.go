@@ -0,0 +1,205 @@
+package clock
+
+import "time"
+
+// ScaledClock ticks on a simulated timeline that runs factor times faster
+// than real time. With factor 60, one simulated hour passes per real minute.
+//
+// Each tick advances simulated time by exactly one step, so tick timestamps
+// are deterministic: tick n is scheduled at start + n*step in simulated time
+// and published as Tick.Scheduled. Now follows the same timeline: it runs
+// continuously between ticks but never passes the next tick's scheduled
+// time, so a subscriber slower than the tick interval slows simulated time
+// down instead of losing ticks.
+// Simulated time does not advance while the clock is paused.
+type ScaledClock struct {
+	core
+	timer  *time.Timer
+	step   time.Duration
+	factor float64
+	start  time.Time
+
+	// Simulated timeline (protected by core.mu)
+	simBase    time.Time // simulated time at realAnchor
+	realAnchor time.Time // real time when simBase was last set while running
+	lastTick   time.Time // simulated time of the most recent tick
+	nextTick   time.Time // simulated time of the upcoming tick
+}
+
+// NewScaledClock creates a clock that ticks every step of simulated time,
+// starting at simulated time start, with the timeline running factor times
+// faster than real time. The real tick interval is step/factor.
+// Panics if step or factor is not positive, or step/factor is below one nanosecond.
+func NewScaledClock(step time.Duration, factor float64, start time.Time) *ScaledClock {
+	if step <= 0 {
+		panic("non-positive step for NewScaledClock")
+	}
+	if factor <= 0 {
+		panic("non-positive factor for NewScaledClock")
+	}
+	if time.Duration(float64(step)/factor) <= 0 {
+		panic("real interval below one nanosecond for NewScaledClock")
+	}
+	return &ScaledClock{
+		core:     newCore(),
+		step:     step,
+		factor:   factor,
+		start:    start,
+		simBase:  start,
+		nextTick: start.Add(step),
+	}
+}
+
+// Start begins generating ticks.
+// Calling Start on a running clock is a no-op, on a paused clock it resumes.
+// Panics if the clock has been stopped.
+func (c *ScaledClock) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case StateIdle:
+		c.timer = time.NewTimer(c.realInterval())
+		c.realAnchor = time.Now()
+		c.state = StateRunning
+		c.wg.Go(c.run)
+	case StatePaused:
+		c.resumeLocked()
+	case StateStopped:
+		panic("cannot start stopped clock")
+	}
+}
+
+// Pause suspends tick generation and freezes simulated time.
+// No-op unless the clock is running.
+func (c *ScaledClock) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return
+	}
+	c.timer.Stop()
+	c.simBase = c.nowLocked()
+	c.state = StatePaused
+}
+
+// Resume continues tick generation and simulated time after Pause.
+// The next tick arrives after the remainder of the interval that was
+// interrupted by Pause.
+// No-op unless the clock is paused.
+func (c *ScaledClock) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StatePaused {
+		return
+	}
+	c.resumeLocked()
+}
+
+// resumeLocked must be called with c.mu held.
+func (c *ScaledClock) resumeLocked() {
+	remaining := time.Duration(float64(c.nextTick.Sub(c.simBase)) / c.factor)
+	c.timer.Reset(max(remaining, 0))
+	c.realAnchor = time.Now()
+	c.state = StateRunning
+}
+
+func (c *ScaledClock) run() {
+	for {
+		select {
+		case <-c.timer.C:
+			// Drop timer fires that raced with Pause
+			scheduled, ok := c.advance()
+			if !ok {
+				continue
+			}
+			if !c.emit(scheduled) {
+				return
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// advance moves the timeline to the upcoming tick, schedules the next one
+// and returns the simulated time of the upcoming tick.
+// Reports whether the tick should be delivered (false if the clock is not running).
+func (c *ScaledClock) advance() (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return time.Time{}, false
+	}
+	c.lastTick = c.nextTick
+	c.nextTick = c.nextTick.Add(c.step)
+	c.simBase = c.lastTick
+	c.realAnchor = time.Now()
+	c.timer.Reset(c.realInterval())
+	return c.lastTick, true
+}
+
+// Stop stops the clock and closes the tick channel.
+// Stopping is terminal. Safe to call multiple times.
+func (c *ScaledClock) Stop() {
+	c.shutdown(func() {
+		c.timer.Stop()
+		if c.state == StateRunning {
+			c.simBase = c.nowLocked()
+		}
+	})
+}
+
+// Now returns the current simulated time.
+// Advances continuously while running, up to the scheduled time of the
+// next tick, and is frozen while paused or stopped.
+func (c *ScaledClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nowLocked()
+}
+
+// nowLocked must be called with c.mu held.
+func (c *ScaledClock) nowLocked() time.Time {
+	if c.state != StateRunning {
+		return c.simBase
+	}
+	elapsed := float64(time.Since(c.realAnchor)) * c.factor
+	now := c.simBase.Add(time.Duration(elapsed))
+	if now.After(c.nextTick) {
+		return c.nextTick
+	}
+	return now
+}
+
+// TickTime returns the simulated time of the most recent tick.
+// Returns the zero time before the first tick.
+func (c *ScaledClock) TickTime() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastTick
+}
+
+// Factor returns how many times faster than real time the simulation runs.
+func (c *ScaledClock) Factor() float64 {
+	return c.factor
+}
+
+// Stats returns current clock metrics.
+// Interval reports the real tick interval (step/factor).
+func (c *ScaledClock) Stats() ClockStats {
+	state := c.State()
+	return ClockStats{
+		TickCount: c.tickCount.Load(),
+		IsRunning: state == StateRunning,
+		State:     state,
+		Interval:  c.realInterval(),
+	}
+}
+
+func (c *ScaledClock) realInterval() time.Duration {
+	return time.Duration(float64(c.step) / c.factor)
+}
@@ -0,0 +1,67 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+)
+
+// checkNow verifies Now lies between the last tick and the next one.
+func checkNow(t *testing.T, clk *clock.ScaledClock, step time.Duration) {
+	t.Helper()
+	now, last := clk.Now(), clk.TickTime()
+	if now.Before(last) || now.After(last.Add(step)) {
+		t.Errorf("Now() = %v, want within one step after TickTime() = %v", now, last)
+	}
+}
+
+// TestScaledClock_Timeline verifies that tick timestamps and Now follow one
+// simulated timeline across Pause/Resume and slow subscribers.
+func TestScaledClock_Timeline(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	step := time.Minute
+	clk := clock.NewScaledClock(step, float64(step/(20*time.Millisecond)), start)
+	ticks := clk.Subscribe()
+	clk.Start()
+	defer clk.Stop()
+
+	want := func(n int) time.Time { return start.Add(time.Duration(n) * step) }
+
+	tick := <-ticks
+	if tick.Seq != 1 || !tick.Scheduled.Equal(want(1)) {
+		t.Fatalf("first tick = %+v, want seq 1 scheduled at %v", tick, want(1))
+	}
+	checkNow(t, clk, step)
+
+	// Pause mid-interval: simulated time freezes between the ticks
+	time.Sleep(10 * time.Millisecond)
+	clk.Pause()
+	paused := clk.Now()
+	checkNow(t, clk, step)
+	time.Sleep(40 * time.Millisecond)
+	if got := clk.Now(); !got.Equal(paused) {
+		t.Errorf("Now() advanced while paused: %v -> %v", paused, got)
+	}
+
+	clk.Resume()
+	tick = <-ticks
+	if !tick.Scheduled.Equal(want(2)) {
+		t.Errorf("tick after Resume scheduled at %v, want %v", tick.Scheduled, want(2))
+	}
+	if !clk.TickTime().Equal(tick.Scheduled) {
+		t.Errorf("TickTime() = %v, want %v", clk.TickTime(), tick.Scheduled)
+	}
+	checkNow(t, clk, step)
+
+	// Slow subscriber: no ticks are skipped and Now waits for them
+	time.Sleep(100 * time.Millisecond)
+	checkNow(t, clk, step)
+	for n := 3; n <= 5; n++ {
+		tick = <-ticks
+		if !tick.Scheduled.Equal(want(n)) {
+			t.Errorf("tick %d scheduled at %v, want %v", tick.Seq, tick.Scheduled, want(n))
+		}
+	}
+	checkNow(t, clk, step)
+}
@@ -0,0 +1,221 @@
+package clock
+
+import "time"
+
+// Schedule determines wall-clock tick times.
+type Schedule interface {
+	// Next returns the first tick time strictly after t.
+	// Returns the zero time if no further tick exists.
+	Next(t time.Time) time.Time
+}
+
+// alignedSchedule ticks on multiples of interval since the Unix epoch.
+type alignedSchedule struct {
+	interval time.Duration
+	offset   time.Duration
+}
+
+// Every returns a Schedule that ticks on multiples of interval since the
+// Unix epoch, shifted by offset. Every(time.Minute, 0) ticks at :00 of
+// every minute, Every(15*time.Second, 5*time.Second) at :05, :20, :35 and :50.
+// Panics if interval is not positive.
+func Every(interval, offset time.Duration) Schedule {
+	if interval <= 0 {
+		panic("non-positive interval for Every")
+	}
+	return alignedSchedule{
+		interval: interval,
+		offset:   offset % interval,
+	}
+}
+
+// Next returns the first aligned boundary strictly after t.
+func (s alignedSchedule) Next(t time.Time) time.Time {
+	d := int64(s.interval)
+	ns := t.UnixNano() - int64(s.offset)
+
+	// Floor division, correct for times before the epoch
+	k := ns / d
+	if ns%d < 0 {
+		k--
+	}
+	return time.Unix(0, (k+1)*d+int64(s.offset)).In(t.Location())
+}
+
+// ScheduleClock emits ticks at the wall-clock times returned by a Schedule.
+// Unlike PeriodicClock, tick times line up with real timestamps regardless
+// of when Start was called.
+type ScheduleClock struct {
+	core
+	schedule Schedule
+	timer    *time.Timer
+
+	// Next scheduled tick (protected by core.mu)
+	next time.Time
+}
+
+// NewScheduleClock creates a clock that ticks according to schedule.
+func NewScheduleClock(schedule Schedule) *ScheduleClock {
+	return &ScheduleClock{
+		core:     newCore(),
+		schedule: schedule,
+	}
+}
+
+// NewAlignedClock creates a clock that ticks on multiples of interval since
+// the Unix epoch. Shorthand for NewScheduleClock(Every(interval, 0)).
+func NewAlignedClock(interval time.Duration) *ScheduleClock {
+	return NewScheduleClock(Every(interval, 0))
+}
+
+// NewCronClock creates a clock that ticks according to a cron expression.
+// See ParseCron for the supported syntax.
+func NewCronClock(expr string) (*ScheduleClock, error) {
+	schedule, err := ParseCron(expr)
+	if err != nil {
+		return nil, err
+	}
+	return NewScheduleClock(schedule), nil
+}
+
+// Start begins generating ticks at the next scheduled time.
+// Calling Start on a running clock is a no-op, on a paused clock it resumes.
+// Panics if the clock has been stopped.
+func (c *ScheduleClock) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.state {
+	case StateIdle:
+		c.timer = time.NewTimer(0)
+		c.timer.Stop()
+		c.armLocked(time.Now())
+		c.state = StateRunning
+		c.wg.Go(c.run)
+	case StatePaused:
+		c.armLocked(time.Now())
+		c.state = StateRunning
+	case StateStopped:
+		panic("cannot start stopped clock")
+	}
+}
+
+// Pause suspends tick generation without closing the tick channel.
+// Scheduled times that pass while paused are skipped.
+// No-op unless the clock is running.
+func (c *ScheduleClock) Pause() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return
+	}
+	c.timer.Stop()
+	c.state = StatePaused
+}
+
+// Resume restarts tick generation at the next scheduled time.
+// No-op unless the clock is paused.
+func (c *ScheduleClock) Resume() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StatePaused {
+		return
+	}
+	c.armLocked(time.Now())
+	c.state = StateRunning
+}
+
+// armLocked schedules the timer for the first tick after t.
+// Leaves the timer stopped if the schedule is exhausted.
+// Must be called with c.mu held.
+func (c *ScheduleClock) armLocked(t time.Time) {
+	c.next = c.schedule.Next(t)
+	if c.next.IsZero() {
+		return
+	}
+	c.timer.Reset(time.Until(c.next))
+}
+
+func (c *ScheduleClock) run() {
+	for {
+		select {
+		case now := <-c.timer.C:
+			scheduled, ok := c.advance(now)
+			if !ok {
+				continue
+			}
+			if !c.emit(scheduled) {
+				return
+			}
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// advance arms the timer for the following tick and returns the scheduled
+// time of the tick that just fired.
+// Reports whether the tick should be delivered (false if the clock is not running).
+func (c *ScheduleClock) advance(now time.Time) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return time.Time{}, false
+	}
+	scheduled := c.next
+	// Never schedule before the tick that just fired, even if the wall
+	// clock reads slightly earlier than the monotonic timer.
+	c.armLocked(latest(now, scheduled))
+	return scheduled, true
+}
+
+// Next returns the next scheduled tick time.
+// Returns the zero time if the clock is not running or the schedule is exhausted.
+func (c *ScheduleClock) Next() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state != StateRunning {
+		return time.Time{}
+	}
+	return c.next
+}
+
+// Stop stops the clock and closes the tick channel.
+// Stopping is terminal. Safe to call multiple times.
+func (c *ScheduleClock) Stop() {
+	c.shutdown(func() { c.timer.Stop() })
+}
+
+// Stats returns current clock metrics.
+// Interval reports the gap between the next scheduled tick and the one after it.
+func (c *ScheduleClock) Stats() ClockStats {
+	c.mu.Lock()
+	state, next := c.state, c.next
+	c.mu.Unlock()
+
+	if next.IsZero() {
+		next = c.schedule.Next(time.Now())
+	}
+	var interval time.Duration
+	if after := c.schedule.Next(next); !next.IsZero() && !after.IsZero() {
+		interval = after.Sub(next)
+	}
+
+	return ClockStats{
+		TickCount: c.tickCount.Load(),
+		IsRunning: state == StateRunning,
+		State:     state,
+		Interval:  interval,
+	}
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
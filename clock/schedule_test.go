@@ -0,0 +1,98 @@
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+)
+
+// TestEvery_Next verifies epoch-aligned boundaries.
+func TestEvery_Next(t *testing.T) {
+	base := time.Date(2025, 3, 10, 12, 7, 23, 500, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule clock.Schedule
+		from     time.Time
+		want     time.Time
+	}{
+		{"minute", clock.Every(time.Minute, 0), base, time.Date(2025, 3, 10, 12, 8, 0, 0, time.UTC)},
+		{"15s", clock.Every(15*time.Second, 0), base, time.Date(2025, 3, 10, 12, 7, 30, 0, time.UTC)},
+		{"15s offset 5s", clock.Every(15*time.Second, 5*time.Second), base, time.Date(2025, 3, 10, 12, 7, 35, 0, time.UTC)},
+		{"on boundary", clock.Every(time.Minute, 0), time.Date(2025, 3, 10, 12, 8, 0, 0, time.UTC), time.Date(2025, 3, 10, 12, 9, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseCron_Next verifies cron field semantics.
+func TestParseCron_Next(t *testing.T) {
+	// Monday
+	base := time.Date(2025, 3, 10, 12, 7, 23, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 3, 10, 12, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 3, 10, 12, 15, 0, 0, time.UTC)},
+		{"*/10 * * * * *", time.Date(2025, 3, 10, 12, 7, 30, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)},
+		{"30 2 1 * *", time.Date(2025, 4, 1, 2, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 15 * 3", time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			schedule, err := clock.ParseCronIn(tt.expr, time.UTC)
+			if err != nil {
+				t.Fatalf("ParseCronIn(%q) error: %v", tt.expr, err)
+			}
+			if got := schedule.Next(base); !got.Equal(tt.want) {
+				t.Errorf("Next = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseCron_Invalid verifies malformed expressions are rejected.
+func TestParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "x * * * *"} {
+		if _, err := clock.ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) succeeded, want error", expr)
+		}
+	}
+}
+
+// TestScheduleClock_Aligned verifies ticks land on aligned boundaries.
+func TestScheduleClock_Aligned(t *testing.T) {
+	interval := 50 * time.Millisecond
+	clk := clock.NewAlignedClock(interval)
+	ticks := clk.Subscribe()
+	clk.Start()
+	defer clk.Stop()
+
+	for range 3 {
+		tick := <-ticks
+		if offset := tick.Scheduled.UnixNano() % int64(interval); offset != 0 {
+			t.Errorf("tick scheduled %v after aligned boundary", time.Duration(offset))
+		}
+		// Loose bound only, delivery may lag under load
+		if lag := tick.Actual.Sub(tick.Scheduled); lag < 0 || lag > time.Second {
+			t.Errorf("tick delivered %v after schedule", lag)
+		}
+	}
+	if got := clk.Stats().Interval; got != interval {
+		t.Errorf("Stats().Interval = %v, want %v", got, interval)
+	}
+}
@@ -68,10 +68,11 @@ func main() {
 	)
 
 	accumulatedStats := accumulated.Stats()
-	fmt.Printf("Accumulated: updates=%d current=%d transforms=%d\n",
+	fmt.Printf("Accumulated: updates=%d current=%d transforms=%d last_tick=%d\n",
 		accumulatedStats.UpdateCount,
 		accumulatedStats.CurrentValue,
 		accumulatedStats.TransformCount,
+		accumulatedStats.LastTick.Seq,
 	)
 
 	resetStats := resetOnRead.Stats()
@@ -0,0 +1,137 @@
+// Package histogram provides a histogram value type for simulating
+// Prometheus/OTLP histogram metrics with value.Value.
+package histogram
+
+import (
+	"math"
+	"slices"
+)
+
+// Histogram counts observations into buckets.
+// Counts[i] is the number of observations v <= Bounds[i] and greater than
+// the previous bound; the last count is the +Inf bucket. Counts are not
+// cumulative, see Cumulative.
+//
+// Histograms are values: Observe and Merge return a new Histogram and never
+// modify the receiver, so a Histogram read from a Value is safe to keep.
+type Histogram struct {
+	Bounds []float64 // upper bounds, strictly ascending, +Inf implicit
+	Counts []uint64  // len(Bounds)+1
+	Sum    float64
+	Count  uint64
+}
+
+// New creates an empty histogram with the given upper bounds.
+// Panics if bounds are not strictly ascending or contain NaN or +Inf.
+func New(bounds ...float64) Histogram {
+	for i, b := range bounds {
+		if math.IsNaN(b) || math.IsInf(b, 1) {
+			panic("NaN or +Inf bound for histogram.New")
+		}
+		if i > 0 && b <= bounds[i-1] {
+			panic("bounds not strictly ascending for histogram.New")
+		}
+	}
+	return Histogram{
+		Bounds: slices.Clone(bounds),
+		Counts: make([]uint64, len(bounds)+1),
+	}
+}
+
+// Observation creates an unbucketed histogram holding the single value v.
+// It is the input format of transform.Bucket, e.g.
+//
+//	source.Map(latency, histogram.Observation)
+func Observation(v float64) Histogram {
+	return Histogram{Sum: v, Count: 1}
+}
+
+// LinearBounds returns count bounds starting at start, width apart.
+// Panics if count is not positive or width is not positive.
+func LinearBounds(start, width float64, count int) []float64 {
+	if count <= 0 || width <= 0 {
+		panic("non-positive count or width for LinearBounds")
+	}
+	bounds := make([]float64, count)
+	for i := range bounds {
+		bounds[i] = start + float64(i)*width
+	}
+	return bounds
+}
+
+// ExponentialBounds returns count bounds starting at start, each factor
+// times the previous one.
+// Panics if count is not positive, start is not positive or factor <= 1.
+func ExponentialBounds(start, factor float64, count int) []float64 {
+	if count <= 0 || start <= 0 || factor <= 1 {
+		panic("invalid parameters for ExponentialBounds")
+	}
+	bounds := make([]float64, count)
+	for i := range bounds {
+		bounds[i] = start * math.Pow(factor, float64(i))
+	}
+	return bounds
+}
+
+// Bucketed reports whether h has buckets. Observations and the zero value
+// are unbucketed.
+func (h Histogram) Bucketed() bool {
+	return len(h.Counts) > 0
+}
+
+// Observe returns a copy of h with v added.
+// Panics if h is unbucketed.
+func (h Histogram) Observe(v float64) Histogram {
+	if !h.Bucketed() {
+		panic("Observe on unbucketed histogram")
+	}
+	out := h.clone()
+	i, _ := slices.BinarySearch(h.Bounds, v) // first bound >= v, len(Bounds) for +Inf
+	out.Counts[i]++
+	out.Sum += v
+	out.Count++
+	return out
+}
+
+// Merge returns a copy of h with all observations of other added.
+// Merging into an unbucketed h adopts the bounds of other; merging the zero
+// value returns h.
+// Panics if other is a non-empty unbucketed histogram or the bounds differ.
+func (h Histogram) Merge(other Histogram) Histogram {
+	if !other.Bucketed() {
+		if other.Count > 0 {
+			panic("Merge of unbucketed histogram")
+		}
+		return h
+	}
+	if !h.Bucketed() {
+		h = New(other.Bounds...)
+	}
+	if !slices.Equal(h.Bounds, other.Bounds) {
+		panic("Merge of histograms with different bounds")
+	}
+	out := h.clone()
+	for i, c := range other.Counts {
+		out.Counts[i] += c
+	}
+	out.Sum += other.Sum
+	out.Count += other.Count
+	return out
+}
+
+// Cumulative returns the cumulative bucket counts (Prometheus "le" buckets),
+// the last one being the +Inf bucket and equal to Count.
+func (h Histogram) Cumulative() []uint64 {
+	cumulative := make([]uint64, len(h.Counts))
+	var total uint64
+	for i, c := range h.Counts {
+		total += c
+		cumulative[i] = total
+	}
+	return cumulative
+}
+
+func (h Histogram) clone() Histogram {
+	h.Counts = slices.Clone(h.Counts)
+	return h
+}
@@ -0,0 +1,105 @@
+// Package numeric provides type-level helpers for generic numeric code.
+package numeric
+
+import "math"
+
+// Number mirrors transform.Numeric; transform cannot be imported here
+// without an import cycle.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// IsFloat reports whether T is a floating-point type.
+func IsFloat[T Number]() bool {
+	var one T = 1
+	return one/2 != 0
+}
+
+// IsSigned reports whether T can represent negative values.
+func IsSigned[T Number]() bool {
+	var zero, one T = 0, 1
+	return zero-one < zero
+}
+
+// IntMax returns the maximum value of integer type T.
+func IntMax[T Number]() T {
+	// Grow an all-ones bit pattern until it overflows
+	var v T = 1
+	for next := v*2 + 1; next > v; next = v*2 + 1 {
+		v = next
+	}
+	return v
+}
+
+// IntBounds returns the minimum and maximum of integer type T as float64.
+func IntBounds[T Number]() (lo, hi float64) {
+	hi = float64(IntMax[T]())
+	if IsSigned[T]() {
+		lo = -hi - 1
+	}
+	return lo, hi
+}
+
+// FromFloat returns a converter from float64 to T.
+// Integer types round to the nearest value and saturate at the bounds of T.
+func FromFloat[T Number]() func(float64) T {
+	if IsFloat[T]() {
+		return func(x float64) T { return T(x) }
+	}
+	lo, hi := IntBounds[T]()
+	return func(x float64) T {
+		switch x = math.Round(x); {
+		case math.IsNaN(x):
+			return 0
+		case x <= lo:
+			return T(lo)
+		case x >= hi:
+			// float64(hi) rounds up for 64-bit types; return the exact max
+			return IntMax[T]()
+		default:
+			return T(x)
+		}
+	}
+}
+
+// AddMod adds n to count modulo modulus, where a modulus of zero means
+// 2^64, and returns the new count and the number of times it wrapped.
+// count must be less than modulus.
+func AddMod(count, n, modulus uint64) (sum, wraps uint64) {
+	if modulus == 0 {
+		sum = count + n
+		if sum < count {
+			wraps = 1
+		}
+		return sum, wraps
+	}
+	if room := modulus - count; n >= room {
+		return (n - room) % modulus, 1 + (n-room)/modulus
+	}
+	return count + n, 0
+}
+
+// AddModFloat adds x to count modulo modulus, where a modulus of zero
+// disables wrapping, and returns the new count and the number of wraps.
+func AddModFloat(count, x, modulus float64) (sum float64, wraps uint64) {
+	sum = count + x
+	if modulus > 0 && sum >= modulus {
+		wraps = uint64(sum / modulus)
+		sum = math.Mod(sum, modulus)
+	}
+	return sum, wraps
+}
+
+// CheckModulus panics with msg if modulus exceeds the range of an integer
+// T; zero stands for 2^64. Float types accept any modulus.
+func CheckModulus[T Number](modulus uint64, msg string) {
+	if IsFloat[T]() {
+		return
+	}
+	limit := uint64(IntMax[T]()) + 1 // 0 for uint64: 2^64
+	if limit != 0 && (modulus == 0 || modulus > limit) {
+		panic(msg)
+	}
+}
@@ -0,0 +1,83 @@
+package source
+
+import (
+	"time"
+
+	"github.com/neox5/simv/clock"
+)
+
+// FuncSource calls a function on each clock tick and publishes its result.
+type FuncSource[T any] struct {
+	Broadcaster[T]
+}
+
+// FromFunc creates a source that publishes fn(tick) on each clock tick.
+// fn is called from a single goroutine and may keep state between calls.
+func FromFunc[T any](clk clock.Clock, fn func(tick clock.Tick) T) *FuncSource[T] {
+	s := &FuncSource[T]{}
+	s.SetGenerator(clk, fn)
+	return s
+}
+
+// ChannelSource publishes values received from an external channel.
+// There is no clock; each received value acts as a tick.
+type ChannelSource[T any] struct {
+	Broadcaster[T]
+	input <-chan T
+}
+
+// FromChannel creates a source that publishes every value received from ch.
+// Each value is stamped with a synthetic tick: sequence numbers count
+// received values and both timestamps are the receive time.
+// Subscriber channels are closed when ch is closed.
+func FromChannel[T any](ch <-chan T) *ChannelSource[T] {
+	s := &ChannelSource[T]{
+		input: ch,
+	}
+	s.SetRunner(s.run)
+	return s
+}
+
+func (s *ChannelSource[T]) run() {
+	var seq uint64
+	for value := range s.input {
+		seq++
+		now := time.Now()
+		s.Publish(Sample[T]{
+			Value: value,
+			Tick:  clock.Tick{Seq: seq, Scheduled: now, Actual: now},
+		})
+	}
+
+	// Input closed, close all subscriber channels
+	s.Close()
+}
+
+// MapSource converts the samples of another source, keeping their ticks.
+type MapSource[In, Out any] struct {
+	Broadcaster[Out]
+	upstream Publisher[In]
+	fn       func(In) Out
+}
+
+// Map creates a source that publishes fn(value) for every sample of src,
+// e.g. to feed observations into a Value of a different type.
+// fn is called from a single goroutine and may keep state between calls.
+// Subscriber channels are closed when src closes.
+func Map[In, Out any](src Publisher[In], fn func(In) Out) *MapSource[In, Out] {
+	s := &MapSource[In, Out]{
+		upstream: src,
+		fn:       fn,
+	}
+	s.SetRunner(s.run)
+	return s
+}
+
+func (s *MapSource[In, Out]) run() {
+	for sample := range s.upstream.Subscribe() {
+		s.Publish(Sample[Out]{Value: s.fn(sample.Value), Tick: sample.Tick})
+	}
+
+	// Upstream closed, close all subscriber channels
+	s.Close()
+}
@@ -0,0 +1,252 @@
+package source
+
+import (
+	"math/rand/v2"
+	"sync"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/internal/numeric"
+	"github.com/neox5/simv/seed"
+	"github.com/neox5/simv/transform"
+)
+
+// AnomalyKind identifies the type of an injected anomaly.
+type AnomalyKind int
+
+const (
+	// Spike adds Magnitude to the upstream value.
+	Spike AnomalyKind = iota
+	// Dip subtracts Magnitude from the upstream value.
+	Dip
+	// Flatline replaces the upstream value with Magnitude.
+	Flatline
+	// Gap suppresses emission entirely.
+	Gap
+	// Stuck repeats the last value emitted before the anomaly started.
+	Stuck
+	// LevelShift adds Magnitude to the upstream value. Identical to Spike
+	// but labeled separately; typically used with Duration 0.
+	LevelShift
+)
+
+// String returns the lowercase kind name.
+func (k AnomalyKind) String() string {
+	switch k {
+	case Spike:
+		return "spike"
+	case Dip:
+		return "dip"
+	case Flatline:
+		return "flatline"
+	case Gap:
+		return "gap"
+	case Stuck:
+		return "stuck"
+	case LevelShift:
+		return "level-shift"
+	default:
+		return "unknown"
+	}
+}
+
+// Anomaly describes a single injection.
+type Anomaly struct {
+	Kind AnomalyKind
+	// Magnitude is the offset for Spike, Dip and LevelShift and the
+	// constant level for Flatline. Ignored by Gap and Stuck.
+	Magnitude float64
+	// Duration is the number of ticks affected. Zero means permanent.
+	Duration int
+}
+
+// AnomalyLabel records when an injection happened, as ground truth for
+// evaluating anomaly detectors.
+type AnomalyLabel struct {
+	Anomaly Anomaly
+	Start   clock.Tick // first affected tick
+	End     clock.Tick // last affected tick, zero while active or permanent
+}
+
+// anomalyRule triggers an anomaly at a fixed tick or with a per-tick probability.
+type anomalyRule struct {
+	anomaly     Anomaly
+	random      bool
+	seq         uint64  // scheduled tick sequence number
+	probability float64 // per-tick trigger probability for random rules
+	active      bool    // random rules do not retrigger while active
+}
+
+// activeAnomaly is an injection currently affecting the output.
+type activeAnomaly struct {
+	rule      *anomalyRule
+	label     int // index into labels
+	remaining int // ticks left, -1 if permanent
+	stuck     float64
+}
+
+// AnomalySource wraps any Publisher and injects spikes, dips, flatlines,
+// gaps, stuck values and level shifts at scheduled or random (seeded) ticks.
+// Overlapping anomalies are applied in activation order; a Gap suppresses
+// the tick regardless of other active anomalies and is excluded from
+// GenerationCount.
+type AnomalySource[T transform.Numeric] struct {
+	Broadcaster[T]
+	upstream Publisher[T]
+	rules    []*anomalyRule
+	rng      *rand.Rand
+	conv     func(float64) T
+
+	// Injection state (owned by run goroutine)
+	active []*activeAnomaly
+	last   float64
+
+	labelsMu sync.Mutex
+	labels   []AnomalyLabel
+}
+
+// NewAnomalySource creates a wrapper around src that passes values through
+// unchanged until anomalies are configured via At and Random.
+// Uses the global seed registry for deterministic sequences when seeded.
+func NewAnomalySource[T transform.Numeric](src Publisher[T]) *AnomalySource[T] {
+	s := &AnomalySource[T]{
+		upstream: src,
+		rng:      seed.NewRand(),
+		conv:     numeric.FromFloat[T](),
+	}
+	s.SetRunner(s.run)
+	return s
+}
+
+// At schedules anomaly to start at the tick with sequence number seq.
+// Tick sequence numbers start at 1.
+// Returns the source for method chaining.
+// Panics if called after the first subscription or if seq is zero.
+func (s *AnomalySource[T]) At(seq uint64, anomaly Anomaly) *AnomalySource[T] {
+	if seq == 0 {
+		panic("zero tick sequence number for At")
+	}
+	s.addRule(&anomalyRule{anomaly: anomaly, seq: seq})
+	return s
+}
+
+// Random starts anomaly with the given probability on each tick.
+// The same rule does not retrigger while its previous injection is active.
+// Returns the source for method chaining.
+// Panics if called after the first subscription or if probability is outside [0, 1].
+func (s *AnomalySource[T]) Random(probability float64, anomaly Anomaly) *AnomalySource[T] {
+	if probability < 0 || probability > 1 {
+		panic("probability outside [0, 1] for Random")
+	}
+	s.addRule(&anomalyRule{anomaly: anomaly, random: true, probability: probability})
+	return s
+}
+
+func (s *AnomalySource[T]) addRule(rule *anomalyRule) {
+	if s.hasSubscribed() {
+		panic("cannot add anomaly after Subscribe()")
+	}
+	if rule.anomaly.Duration < 0 {
+		panic("negative anomaly duration")
+	}
+	s.rules = append(s.rules, rule)
+}
+
+func (s *AnomalySource[T]) run() {
+	for sample := range s.upstream.Subscribe() {
+		s.trigger(sample.Tick)
+
+		value, emit := s.apply(float64(sample.Value))
+		s.expire(sample.Tick)
+		if !emit {
+			continue
+		}
+
+		sample.Value = s.conv(value)
+		s.last = float64(sample.Value)
+		s.Publish(sample)
+	}
+
+	// Upstream closed, close all subscriber channels
+	s.Close()
+}
+
+// trigger activates scheduled and random rules for tick.
+func (s *AnomalySource[T]) trigger(tick clock.Tick) {
+	for _, rule := range s.rules {
+		var fire bool
+		if rule.random {
+			fire = !rule.active && s.rng.Float64() < rule.probability
+		} else {
+			fire = rule.seq == tick.Seq
+		}
+		if !fire {
+			continue
+		}
+
+		rule.active = true
+		s.labelsMu.Lock()
+		s.labels = append(s.labels, AnomalyLabel{Anomaly: rule.anomaly, Start: tick})
+		label := len(s.labels) - 1
+		s.labelsMu.Unlock()
+
+		remaining := rule.anomaly.Duration
+		if remaining == 0 {
+			remaining = -1
+		}
+		s.active = append(s.active, &activeAnomaly{
+			rule:      rule,
+			label:     label,
+			remaining: remaining,
+			stuck:     s.last,
+		})
+	}
+}
+
+// apply runs value through all active anomalies.
+// Reports false if the tick is suppressed by a Gap.
+func (s *AnomalySource[T]) apply(value float64) (float64, bool) {
+	emit := true
+	for _, a := range s.active {
+		switch a.rule.anomaly.Kind {
+		case Spike, LevelShift:
+			value += a.rule.anomaly.Magnitude
+		case Dip:
+			value -= a.rule.anomaly.Magnitude
+		case Flatline:
+			value = a.rule.anomaly.Magnitude
+		case Stuck:
+			value = a.stuck
+		case Gap:
+			emit = false
+		}
+	}
+	return value, emit
+}
+
+// expire counts down active anomalies and closes labels of finished ones.
+func (s *AnomalySource[T]) expire(tick clock.Tick) {
+	kept := s.active[:0]
+	for _, a := range s.active {
+		if a.remaining > 0 {
+			a.remaining--
+		}
+		if a.remaining != 0 {
+			kept = append(kept, a)
+			continue
+		}
+
+		a.rule.active = false
+		s.labelsMu.Lock()
+		s.labels[a.label].End = tick
+		s.labelsMu.Unlock()
+	}
+	clear(s.active[len(kept):])
+	s.active = kept
+}
+
+// Labels returns all injections so far in activation order.
+func (s *AnomalySource[T]) Labels() []AnomalyLabel {
+	s.labelsMu.Lock()
+	defer s.labelsMu.Unlock()
+	return append([]AnomalyLabel(nil), s.labels...)
+}
@@ -0,0 +1,70 @@
+package source_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+)
+
+// TestAnomalySource_Labels verifies scheduled injections and their
+// ground-truth labels.
+func TestAnomalySource_Labels(t *testing.T) {
+	input := make(chan int)
+	src := source.NewAnomalySource[int](source.FromChannel(input)).
+		At(2, source.Anomaly{Kind: source.Gap, Duration: 2}).
+		At(5, source.Anomaly{Kind: source.Stuck, Duration: 2}).
+		Random(0, source.Anomaly{Kind: source.Spike, Magnitude: 100})
+	samples := src.Subscribe()
+
+	go func() {
+		for v := 1; v <= 8; v++ {
+			input <- v
+		}
+		close(input)
+	}()
+
+	var got []int
+	for sample := range samples {
+		got = append(got, sample.Value)
+	}
+
+	// Ticks 2-3 are suppressed, ticks 5-6 repeat the value of tick 4
+	if want := []int{1, 4, 4, 4, 7, 8}; !slices.Equal(got, want) {
+		t.Errorf("values = %v, want %v", got, want)
+	}
+	if got := src.Stats().GenerationCount; got != 6 {
+		t.Errorf("GenerationCount = %d, want 6 (gap ticks excluded)", got)
+	}
+
+	labels := src.Labels()
+	if len(labels) != 2 {
+		t.Fatalf("got %d labels, want 2 (Random(0) must never fire): %+v", len(labels), labels)
+	}
+	for i, want := range []struct {
+		kind       source.AnomalyKind
+		start, end uint64
+	}{
+		{source.Gap, 2, 3},
+		{source.Stuck, 5, 6},
+	} {
+		l := labels[i]
+		if l.Anomaly.Kind != want.kind || l.Start.Seq != want.start || l.End.Seq != want.end {
+			t.Errorf("label %d = %v ticks %d-%d, want %v ticks %d-%d",
+				i, l.Anomaly.Kind, l.Start.Seq, l.End.Seq, want.kind, want.start, want.end)
+		}
+	}
+}
+
+// TestAnomalySource_AtZero verifies tick zero, which never occurs, is rejected.
+func TestAnomalySource_AtZero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("At(0, ...) did not panic")
+		}
+	}()
+	clk := clock.NewPeriodicClock(time.Millisecond)
+	source.NewAnomalySource[int](source.NewConstSource(clk, 1)).At(0, source.Anomaly{Kind: source.Spike})
+}
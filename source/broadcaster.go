@@ -0,0 +1,176 @@
+package source
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/neox5/simv/clock"
+)
+
+// Broadcaster implements the subscriber side of a source: subscriptions with
+// delivery policies, unsubscribe, fan-out, close propagation and SourceStats.
+// All built-in sources embed it, and user-defined sources can do the same:
+//
+//	type SineSource struct {
+//		source.Broadcaster[float64]
+//	}
+//
+//	func NewSineSource(clk clock.Clock) *SineSource {
+//		s := &SineSource{}
+//		s.SetGenerator(clk, func(tick clock.Tick) float64 {
+//			return math.Sin(float64(tick.Seq) / 10)
+//		})
+//		return s
+//	}
+//
+// Production starts lazily on the first subscription. Publish and Close are
+// intended for the embedding source only.
+// The zero value is ready to use; a Broadcaster must not be copied.
+type Broadcaster[T any] struct {
+	runOnce sync.Once
+	runner  func()
+
+	mu             sync.Mutex
+	subscribers    []*subscriber[T]
+	subscribed     bool
+	closed         bool
+	retiredDropped uint64 // drops of unsubscribed subscriptions
+
+	generationCount atomic.Uint64
+}
+
+// SetRunner registers run to be started in its own goroutine on the first
+// subscription. run must call Publish for each sample and Close when done.
+// Panics if called after the first subscription.
+func (b *Broadcaster[T]) SetRunner(run func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subscribed {
+		panic("cannot set runner after Subscribe()")
+	}
+	b.runner = run
+}
+
+// SetGenerator drives the broadcaster from a clock: on the first
+// subscription it subscribes to clk, publishes generate(tick) for every tick
+// and closes all subscriptions when the clock stops.
+// generate is called from a single goroutine and may keep state between calls.
+// Panics if called after the first subscription.
+func (b *Broadcaster[T]) SetGenerator(clk clock.Clock, generate func(tick clock.Tick) T) {
+	b.SetRunner(func() {
+		for tick := range clk.Subscribe() {
+			b.Publish(Sample[T]{Value: generate(tick), Tick: tick})
+		}
+
+		// Clock closed, close all subscriber channels
+		b.Close()
+	})
+}
+
+// hasSubscribed reports whether a subscription was ever made.
+// Used by sources to lock their configuration.
+func (b *Broadcaster[T]) hasSubscribed() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.subscribed
+}
+
+// Subscribe returns an unbuffered, blocking subscription.
+func (b *Broadcaster[T]) Subscribe() <-chan Sample[T] {
+	return b.SubscribeWith(Delivery{})
+}
+
+// SubscribeWith returns a subscription using the given delivery policy.
+// Subscribing after Close returns an already closed channel.
+func (b *Broadcaster[T]) SubscribeWith(d Delivery) <-chan Sample[T] {
+	sub := newSubscriber[T](d)
+
+	b.mu.Lock()
+	b.subscribed = true
+	runner := b.runner
+	if b.closed {
+		b.mu.Unlock()
+		sub.close()
+		return sub.ch
+	}
+	b.subscribers = append(b.subscribers, sub)
+	b.mu.Unlock()
+
+	if runner != nil {
+		b.runOnce.Do(func() { go runner() })
+	}
+	return sub.ch
+}
+
+// Unsubscribe removes the subscription and closes its channel.
+// A delivery blocked on the subscription is released.
+// No-op if ch is not an active subscription.
+func (b *Broadcaster[T]) Unsubscribe(ch <-chan Sample[T]) {
+	b.mu.Lock()
+	i := slices.IndexFunc(b.subscribers, func(sub *subscriber[T]) bool {
+		return (<-chan Sample[T])(sub.ch) == ch
+	})
+	if i < 0 {
+		b.mu.Unlock()
+		return
+	}
+	sub := b.subscribers[i]
+	// Copy so in-flight Publish calls keep iterating a consistent slice
+	b.subscribers = slices.Delete(slices.Clone(b.subscribers), i, i+1)
+	b.mu.Unlock()
+
+	// Close before retiring the drop count so no later drop is lost
+	sub.close()
+
+	b.mu.Lock()
+	b.retiredDropped += sub.dropped.Load()
+	b.mu.Unlock()
+}
+
+// Publish counts a generation and delivers sample to every subscriber
+// according to its delivery policy.
+func (b *Broadcaster[T]) Publish(sample Sample[T]) {
+	b.generationCount.Add(1)
+
+	b.mu.Lock()
+	subs := b.subscribers
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.deliver(sample)
+	}
+}
+
+// Close closes all subscriber channels. Later subscriptions receive an
+// already closed channel. Safe to call multiple times.
+func (b *Broadcaster[T]) Close() {
+	b.mu.Lock()
+	b.closed = true
+	subs := b.subscribers
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.close()
+	}
+}
+
+// Stats returns source metrics aggregated over all subscriptions.
+// DroppedCount includes drops of subscriptions that have since unsubscribed.
+func (b *Broadcaster[T]) Stats() SourceStats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := SourceStats{
+		GenerationCount: b.generationCount.Load(),
+		SubscriberCount: len(b.subscribers),
+		DroppedCount:    b.retiredDropped,
+		Subscribers:     make([]SubscriberStats, len(b.subscribers)),
+	}
+	for i, sub := range b.subscribers {
+		stats.Subscribers[i] = sub.stats()
+		stats.DroppedCount += stats.Subscribers[i].Dropped
+	}
+	return stats
+}
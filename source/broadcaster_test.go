@@ -0,0 +1,86 @@
+package source_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+)
+
+// counterSource is a user-defined source built on the shared Broadcaster.
+type counterSource struct {
+	source.Broadcaster[int]
+	n int
+}
+
+func newCounterSource(clk clock.Clock) *counterSource {
+	s := &counterSource{}
+	s.SetGenerator(clk, func(clock.Tick) int {
+		s.n++
+		return s.n
+	})
+	return s
+}
+
+// TestBroadcaster_EmbeddedSource verifies a user-defined source gets
+// fan-out, stats and close propagation from the embedded Broadcaster.
+func TestBroadcaster_EmbeddedSource(t *testing.T) {
+	clk := clock.NewPeriodicClock(time.Millisecond)
+	var src source.Publisher[int] = newCounterSource(clk)
+
+	a, b := src.Subscribe(), src.Subscribe()
+	clk.Start()
+
+	for want := 1; want <= 3; want++ {
+		if got := (<-a).Value; got != want {
+			t.Errorf("subscriber a got %d, want %d", got, want)
+		}
+		if got := (<-b).Value; got != want {
+			t.Errorf("subscriber b got %d, want %d", got, want)
+		}
+	}
+
+	clk.Stop()
+	for range a {
+	}
+	for range b {
+	}
+
+	if stats := src.Stats(); stats.SubscriberCount != 2 || stats.GenerationCount < 3 {
+		t.Errorf("stats = %+v, want 2 subscribers and >= 3 generations", stats)
+	}
+}
+
+// TestBroadcaster_UnsubscribeReleasesBlockedDelivery verifies that an
+// abandoned blocking subscription no longer stalls the other subscribers.
+func TestBroadcaster_UnsubscribeReleasesBlockedDelivery(t *testing.T) {
+	clk := clock.NewPeriodicClock(time.Millisecond)
+	src := source.NewConstSource(clk, 1)
+
+	abandoned := src.Subscribe()
+	active := src.Subscribe()
+	clk.Start()
+	defer clk.Stop()
+
+	<-abandoned
+	<-active
+
+	// The source is now blocked delivering to abandoned
+	src.Unsubscribe(abandoned)
+
+	for range 5 {
+		select {
+		case <-active:
+		case <-time.After(time.Second):
+			t.Fatal("active subscriber stalled after Unsubscribe")
+		}
+	}
+
+	// Unsubscribe closed the channel, so draining terminates
+	for range abandoned {
+	}
+	if got := src.Stats().SubscriberCount; got != 1 {
+		t.Errorf("SubscriberCount = %d, want 1", got)
+	}
+}
@@ -0,0 +1,114 @@
+package source
+
+import (
+	"math/rand/v2"
+	"sort"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/seed"
+)
+
+// Weighted pairs a category with its relative weight.
+type Weighted[T any] struct {
+	Value  T
+	Weight float64
+}
+
+// CategoricalSource emits values from a weighted set of categories, either
+// independently on each tick or following a Markov chain so states persist.
+// Works for any comparable type, e.g. status strings or HTTP status codes.
+type CategoricalSource[T comparable] struct {
+	Broadcaster[T]
+}
+
+// NewCategoricalSource creates a source that draws an independent category
+// on every tick with probability proportional to its weight.
+// Uses the global seed registry for deterministic sequences when seeded.
+// Panics if categories is empty, a weight is negative or all weights are zero.
+func NewCategoricalSource[T comparable](clk clock.Clock, categories []Weighted[T]) *CategoricalSource[T] {
+	s := &CategoricalSource[T]{}
+	rng := seed.NewRand()
+	table := newWeightTable(categories)
+	s.SetGenerator(clk, func(clock.Tick) T {
+		return table.pick(rng)
+	})
+	return s
+}
+
+// NewMarkovSource creates a source that starts in state initial and on each
+// subsequent tick moves to a next state drawn from transitions[current].
+// The first tick emits initial.
+// Uses the global seed registry for deterministic sequences when seeded.
+// Panics if initial or any reachable state has no transitions, or if the
+// weights of a state are invalid (see NewCategoricalSource).
+func NewMarkovSource[T comparable](clk clock.Clock, initial T, transitions map[T][]Weighted[T]) *CategoricalSource[T] {
+	s := &CategoricalSource[T]{}
+	rng := seed.NewRand()
+
+	tables := make(map[T]weightTable[T], len(transitions))
+	for state, next := range transitions {
+		tables[state] = newWeightTable(next)
+	}
+	if _, ok := tables[initial]; !ok {
+		panic("initial state has no transitions for NewMarkovSource")
+	}
+	for _, table := range tables {
+		for _, target := range table.values {
+			if _, ok := tables[target]; !ok {
+				panic("reachable state has no transitions for NewMarkovSource")
+			}
+		}
+	}
+
+	current, started := initial, false
+	s.SetGenerator(clk, func(clock.Tick) T {
+		if started {
+			current = tables[current].pick(rng)
+		}
+		started = true
+		return current
+	})
+	return s
+}
+
+// weightTable samples values by cumulative weight.
+type weightTable[T any] struct {
+	values     []T
+	cumulative []float64
+}
+
+func newWeightTable[T any](categories []Weighted[T]) weightTable[T] {
+	if len(categories) == 0 {
+		panic("no categories for weighted selection")
+	}
+
+	table := weightTable[T]{
+		values:     make([]T, 0, len(categories)),
+		cumulative: make([]float64, 0, len(categories)),
+	}
+	var total float64
+	for _, c := range categories {
+		if c.Weight < 0 {
+			panic("negative weight for weighted selection")
+		}
+		total += c.Weight
+		table.values = append(table.values, c.Value)
+		table.cumulative = append(table.cumulative, total)
+	}
+	if total == 0 {
+		panic("all weights zero for weighted selection")
+	}
+	return table
+}
+
+// pick returns a value with probability proportional to its weight.
+func (t weightTable[T]) pick(rng *rand.Rand) T {
+	total := t.cumulative[len(t.cumulative)-1]
+	u := rng.Float64() * total
+
+	// First entry whose cumulative weight exceeds u; skips zero weights
+	i := sort.Search(len(t.cumulative), func(i int) bool {
+		return t.cumulative[i] > u
+	})
+	return t.values[min(i, len(t.values)-1)]
+}
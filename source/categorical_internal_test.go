@@ -0,0 +1,27 @@
+package source
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// fixedSource makes rand.Float64 return 0.5.
+type fixedSource struct{}
+
+func (fixedSource) Uint64() uint64 { return 1 << 52 } // low 53 bits are used
+
+// TestWeightTable_SkipsZeroWeights verifies a draw landing exactly on a
+// cumulative boundary never selects a zero-weight category.
+func TestWeightTable_SkipsZeroWeights(t *testing.T) {
+	table := newWeightTable([]Weighted[string]{
+		{Value: "a", Weight: 1},
+		{Value: "b", Weight: 0},
+		{Value: "c", Weight: 0},
+		{Value: "d", Weight: 1},
+	})
+
+	// u = 0.5 * total = 1.0, the cumulative weight of a, b and c
+	if got := table.pick(rand.New(fixedSource{})); got != "d" {
+		t.Errorf("pick() = %q, want %q", got, "d")
+	}
+}
@@ -0,0 +1,64 @@
+package source_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+)
+
+// TestMarkovSource verifies the chain emits initial first and then follows
+// its transitions.
+func TestMarkovSource(t *testing.T) {
+	// Deterministic cycle: zero weights are never picked
+	transitions := map[string][]source.Weighted[string]{
+		"boot":     {{Value: "up", Weight: 1}, {Value: "boot", Weight: 0}},
+		"up":       {{Value: "degraded", Weight: 1}},
+		"degraded": {{Value: "up", Weight: 1}},
+	}
+	got := collect(5, func(clk clock.Clock) source.Publisher[string] {
+		return source.NewMarkovSource(clk, "boot", transitions)
+	})
+
+	if want := []string{"boot", "up", "degraded", "up", "degraded"}; !slices.Equal(got, want) {
+		t.Errorf("states = %v, want %v", got, want)
+	}
+}
+
+// TestMarkovSource_InvalidTransitions verifies transition validation.
+func TestMarkovSource_InvalidTransitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		initial     string
+		transitions map[string][]source.Weighted[string]
+	}{
+		{"initial without transitions", "a", map[string][]source.Weighted[string]{
+			"b": {{Value: "b", Weight: 1}},
+		}},
+		{"reachable state without transitions", "a", map[string][]source.Weighted[string]{
+			"a": {{Value: "b", Weight: 1}},
+		}},
+		{"empty transitions", "a", map[string][]source.Weighted[string]{
+			"a": {},
+		}},
+		{"negative weight", "a", map[string][]source.Weighted[string]{
+			"a": {{Value: "a", Weight: -1}},
+		}},
+		{"all weights zero", "a", map[string][]source.Weighted[string]{
+			"a": {{Value: "a", Weight: 0}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("NewMarkovSource did not panic")
+				}
+			}()
+			source.NewMarkovSource(clock.NewPeriodicClock(time.Millisecond), tt.initial, tt.transitions)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package source
+
+import (
+	"math/rand/v2"
+	"sync/atomic"
+
+	"github.com/neox5/simv/internal/numeric"
+	"github.com/neox5/simv/seed"
+	"github.com/neox5/simv/transform"
+)
+
+// CounterStats contains observable metrics specific to a CounterSource.
+type CounterStats struct {
+	Resets uint64 // simulated process restarts
+	Wraps  uint64 // overflows past the wrap modulus
+}
+
+// CounterSource turns an increment source into a monotonic counter, like a
+// Prometheus counter or SNMP octet counter. The counter occasionally resets
+// to zero (process restart) and wraps at a configurable modulus, so
+// rate()/increase() style reset handling can be tested.
+//
+// Integer counters wrap at the maximum of T by default (e.g. 2^32 for
+// uint32); float counters never wrap unless WrapAt is set. Negative
+// increments are ignored.
+type CounterSource[T transform.Numeric] struct {
+	Broadcaster[T]
+	increments Publisher[T]
+	resetProb  float64
+	modulus    uint64 // 0 wraps at 2^64
+	rng        *rand.Rand
+
+	// Counter state (owned by run goroutine)
+	count  uint64
+	fcount float64
+
+	resets atomic.Uint64
+	wraps  atomic.Uint64
+}
+
+// NewCounterSource creates a counter that adds each value of increments,
+// e.g. a Poisson or Pareto source driven by the same clock.
+// Uses the global seed registry for deterministic resets when seeded.
+func NewCounterSource[T transform.Numeric](increments Publisher[T]) *CounterSource[T] {
+	s := &CounterSource[T]{
+		increments: increments,
+		rng:        seed.NewRand(),
+	}
+	if !numeric.IsFloat[T]() {
+		s.modulus = uint64(numeric.IntMax[T]()) + 1
+	}
+	s.SetRunner(s.run)
+	return s
+}
+
+// ResetProbability sets the per-tick probability of resetting the counter
+// to zero before the increment is applied.
+// Returns the source for method chaining.
+// Panics if called after the first subscription or if p is outside [0, 1].
+func (s *CounterSource[T]) ResetProbability(p float64) *CounterSource[T] {
+	if s.hasSubscribed() {
+		panic("cannot set reset probability after Subscribe()")
+	}
+	if p < 0 || p > 1 {
+		panic("p outside [0, 1] for ResetProbability")
+	}
+	s.resetProb = p
+	return s
+}
+
+// WrapAt sets the modulus the counter wraps at, e.g. 1<<32 for SNMP
+// Counter32. Zero disables wrapping for float counters and wraps at 2^64
+// for integer counters.
+// Returns the source for method chaining.
+// Panics if called after the first subscription or if modulus exceeds the
+// range of an integer T.
+func (s *CounterSource[T]) WrapAt(modulus uint64) *CounterSource[T] {
+	if s.hasSubscribed() {
+		panic("cannot set wrap modulus after Subscribe()")
+	}
+	numeric.CheckModulus[T](modulus, "modulus exceeds range of T for WrapAt")
+	s.modulus = modulus
+	return s
+}
+
+func (s *CounterSource[T]) run() {
+	for sample := range s.increments.Subscribe() {
+		if s.resetProb > 0 && s.rng.Float64() < s.resetProb {
+			s.count, s.fcount = 0, 0
+			s.resets.Add(1)
+		}
+
+		if numeric.IsFloat[T]() {
+			sample.Value = s.addFloat(float64(sample.Value))
+		} else {
+			sample.Value = s.addInt(sample.Value)
+		}
+		s.Publish(sample)
+	}
+
+	// Upstream closed, close all subscriber channels
+	s.Close()
+}
+
+// addInt adds a non-negative increment modulo s.modulus.
+func (s *CounterSource[T]) addInt(inc T) T {
+	if inc > 0 {
+		var wraps uint64
+		s.count, wraps = numeric.AddMod(s.count, uint64(inc), s.modulus)
+		s.wraps.Add(wraps)
+	}
+	return T(s.count)
+}
+
+// addFloat adds a non-negative increment, wrapping if a modulus is set.
+func (s *CounterSource[T]) addFloat(inc float64) T {
+	if inc > 0 {
+		var wraps uint64
+		s.fcount, wraps = numeric.AddModFloat(s.fcount, inc, float64(s.modulus))
+		s.wraps.Add(wraps)
+	}
+	return T(s.fcount)
+}
+
+// CounterStats returns the number of resets and wraps so far.
+func (s *CounterSource[T]) CounterStats() CounterStats {
+	return CounterStats{
+		Resets: s.resets.Load(),
+		Wraps:  s.wraps.Load(),
+	}
+}
@@ -0,0 +1,105 @@
+package source_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/neox5/simv/source"
+	"github.com/neox5/simv/transform"
+)
+
+// runCounter feeds increments through a CounterSource configured by
+// configure and returns the emitted counter values and final stats.
+func runCounter[T transform.Numeric](increments []T, configure func(*source.CounterSource[T])) ([]T, source.CounterStats) {
+	input := make(chan T)
+	src := source.NewCounterSource[T](source.FromChannel(input))
+	if configure != nil {
+		configure(src)
+	}
+	samples := src.Subscribe()
+
+	go func() {
+		for _, inc := range increments {
+			input <- inc
+		}
+		close(input)
+	}()
+
+	var values []T
+	for sample := range samples {
+		values = append(values, sample.Value)
+	}
+	return values, src.CounterStats()
+}
+
+// TestCounterSource verifies default and explicit wrap moduli, ignored
+// negative increments and resets.
+func TestCounterSource(t *testing.T) {
+	t.Run("int8 wraps at 2^7", func(t *testing.T) {
+		got, stats := runCounter([]int8{100, -5, 50, 127}, nil)
+		if want := []int8{100, 100, 22, 21}; !slices.Equal(got, want) {
+			t.Errorf("values = %v, want %v", got, want)
+		}
+		if stats.Wraps != 2 || stats.Resets != 0 {
+			t.Errorf("stats = %+v, want 2 wraps, 0 resets", stats)
+		}
+	})
+
+	t.Run("uint64 wraps at 2^64", func(t *testing.T) {
+		got, stats := runCounter([]uint64{math.MaxUint64, 2}, nil)
+		if want := []uint64{math.MaxUint64, 1}; !slices.Equal(got, want) {
+			t.Errorf("values = %v, want %v", got, want)
+		}
+		if stats.Wraps != 1 {
+			t.Errorf("Wraps = %d, want 1", stats.Wraps)
+		}
+	})
+
+	t.Run("WrapAt", func(t *testing.T) {
+		got, stats := runCounter([]uint32{600, 600, 1900}, func(s *source.CounterSource[uint32]) {
+			s.WrapAt(1000)
+		})
+		if want := []uint32{600, 200, 100}; !slices.Equal(got, want) {
+			t.Errorf("values = %v, want %v", got, want)
+		}
+		if stats.Wraps != 3 {
+			t.Errorf("Wraps = %d, want 3", stats.Wraps)
+		}
+	})
+
+	t.Run("float WrapAt", func(t *testing.T) {
+		got, stats := runCounter([]float64{0.75, 0.5}, func(s *source.CounterSource[float64]) {
+			s.WrapAt(1)
+		})
+		if want := []float64{0.75, 0.25}; !slices.Equal(got, want) {
+			t.Errorf("values = %v, want %v", got, want)
+		}
+		if stats.Wraps != 1 {
+			t.Errorf("Wraps = %d, want 1", stats.Wraps)
+		}
+	})
+
+	t.Run("ResetProbability(1)", func(t *testing.T) {
+		// Every tick resets before the increment is applied
+		got, stats := runCounter([]int{3, 4, 5}, func(s *source.CounterSource[int]) {
+			s.ResetProbability(1)
+		})
+		if want := []int{3, 4, 5}; !slices.Equal(got, want) {
+			t.Errorf("values = %v, want %v", got, want)
+		}
+		if stats.Resets != 3 || stats.Wraps != 0 {
+			t.Errorf("stats = %+v, want 3 resets, 0 wraps", stats)
+		}
+	})
+}
+
+// TestCounterSource_WrapAtOutOfRange verifies moduli beyond the type are rejected.
+func TestCounterSource_WrapAtOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("WrapAt(1<<8) on int8 did not panic")
+		}
+	}()
+	source.NewCounterSource[int8](source.FromChannel(make(chan int8))).WrapAt(1 << 8)
+}
@@ -0,0 +1,145 @@
+package source
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Policy controls what happens when a subscriber is not ready to receive.
+type Policy int
+
+const (
+	// Block waits until the subscriber receives the sample.
+	// A slow subscriber stalls the source, all other subscribers and the clock.
+	Block Policy = iota
+	// DropNewest discards the incoming sample when the buffer is full.
+	DropNewest
+	// DropOldest discards the oldest buffered sample to make room for the incoming one.
+	DropOldest
+	// LatestOnly keeps only the most recent sample (DropOldest with a buffer of one).
+	LatestOnly
+)
+
+// String returns the lowercase policy name.
+func (p Policy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropNewest:
+		return "drop-newest"
+	case DropOldest:
+		return "drop-oldest"
+	case LatestOnly:
+		return "latest-only"
+	default:
+		return "unknown"
+	}
+}
+
+// Delivery configures how samples are delivered to a single subscription.
+// The zero value is an unbuffered, blocking subscription (same as Subscribe).
+type Delivery struct {
+	Policy Policy
+	// Buffer is the channel capacity. DropOldest requires at least one slot
+	// and uses one if Buffer is zero; LatestOnly ignores Buffer.
+	Buffer int
+}
+
+// SubscriberStats contains observable metrics for a single subscription.
+type SubscriberStats struct {
+	Policy  Policy
+	Pending int    // samples buffered but not yet received
+	Dropped uint64 // samples discarded by the policy
+}
+
+// subscriber delivers samples to one subscription according to its policy.
+type subscriber[T any] struct {
+	ch      chan Sample[T]
+	policy  Policy
+	dropped atomic.Uint64
+
+	// done is closed on unsubscribe to release a blocked delivery;
+	// mu serializes delivery with closing ch.
+	done     chan struct{}
+	doneOnce sync.Once
+	mu       sync.Mutex
+	closed   bool
+}
+
+func newSubscriber[T any](d Delivery) *subscriber[T] {
+	buffer := max(d.Buffer, 0)
+	switch d.Policy {
+	case DropOldest:
+		buffer = max(buffer, 1)
+	case LatestOnly:
+		buffer = 1
+	}
+	return &subscriber[T]{
+		ch:     make(chan Sample[T], buffer),
+		policy: d.Policy,
+		done:   make(chan struct{}),
+	}
+}
+
+// deliver sends sample according to the subscription policy.
+// Only Block may wait for the receiver, until the subscription is closed.
+func (s *subscriber[T]) deliver(sample Sample[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	switch s.policy {
+	case DropNewest:
+		select {
+		case s.ch <- sample:
+		default:
+			s.dropped.Add(1)
+		}
+	case DropOldest, LatestOnly:
+		for {
+			select {
+			case s.ch <- sample:
+				return
+			default:
+			}
+			// Buffer full, evict the oldest sample unless the receiver got it first
+			select {
+			case <-s.ch:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- sample:
+		case <-s.done:
+		}
+	}
+}
+
+// close releases a blocked delivery and closes the channel.
+// Safe to call multiple times.
+func (s *subscriber[T]) close() {
+	// Must happen before taking mu, which a blocked delivery holds
+	s.doneOnce.Do(func() { close(s.done) })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.ch)
+}
+
+func (s *subscriber[T]) stats() SubscriberStats {
+	return SubscriberStats{
+		Policy:  s.policy,
+		Pending: len(s.ch),
+		Dropped: s.dropped.Load(),
+	}
+}
@@ -0,0 +1,55 @@
+package source_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+)
+
+// TestDelivery_SlowSubscriberDoesNotStall verifies that non-blocking policies
+// isolate a subscriber that never reads from the rest of the pipeline.
+func TestDelivery_SlowSubscriberDoesNotStall(t *testing.T) {
+	clk := clock.NewPeriodicClock(time.Millisecond)
+	src := source.NewConstSource(clk, 1)
+
+	dropNewest := src.SubscribeWith(source.Delivery{Policy: source.DropNewest, Buffer: 2})
+	latest := src.SubscribeWith(source.Delivery{Policy: source.LatestOnly})
+	fast := src.Subscribe()
+
+	clk.Start()
+	for range 20 {
+		<-fast
+	}
+	clk.Stop()
+	for range fast {
+	}
+
+	stats := src.Stats()
+	generations := stats.GenerationCount
+	if generations < 20 {
+		t.Fatalf("GenerationCount = %d, want >= 20", generations)
+	}
+
+	if got := stats.Subscribers[0]; got.Pending != 2 || got.Dropped != generations-2 {
+		t.Errorf("drop-newest stats = %+v, want pending 2, dropped %d", got, generations-2)
+	}
+	if got := stats.Subscribers[1]; got.Pending != 1 || got.Dropped != generations-1 {
+		t.Errorf("latest-only stats = %+v, want pending 1, dropped %d", got, generations-1)
+	}
+	if got := stats.Subscribers[2].Dropped; got != 0 {
+		t.Errorf("blocking subscriber dropped %d samples", got)
+	}
+	if want := 2*generations - 3; stats.DroppedCount != want {
+		t.Errorf("DroppedCount = %d, want %d", stats.DroppedCount, want)
+	}
+
+	// Drop-newest keeps the first samples, latest-only the most recent one
+	if sample := <-dropNewest; sample.Tick.Seq != 1 {
+		t.Errorf("drop-newest first sample seq = %d, want 1", sample.Tick.Seq)
+	}
+	if sample := <-latest; sample.Tick.Seq != generations {
+		t.Errorf("latest-only sample seq = %d, want %d", sample.Tick.Seq, generations)
+	}
+}
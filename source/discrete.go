@@ -0,0 +1,157 @@
+package source
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/transform"
+)
+
+// NewPoissonSource creates a source emitting the number of events per tick
+// for a Poisson process with mean lambda. Typical for error and request
+// counters piped through transform.Accumulate.
+// Panics if lambda is negative.
+func NewPoissonSource[T transform.Numeric](clk clock.Clock, lambda float64) *DistributionSource[T] {
+	if lambda < 0 {
+		panic("negative lambda for NewPoissonSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		return poisson(rng, lambda)
+	})
+}
+
+// NewBinomialSource creates a source emitting the number of successes in
+// n independent trials with success probability p.
+// Exact for n*min(p, 1-p) < 30, normal approximation above.
+// Panics if n is negative or p is outside [0, 1].
+func NewBinomialSource[T transform.Numeric](clk clock.Clock, n int, p float64) *DistributionSource[T] {
+	if n < 0 {
+		panic("negative n for NewBinomialSource")
+	}
+	if p < 0 || p > 1 {
+		panic("p outside [0, 1] for NewBinomialSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		return binomial(rng, n, p)
+	})
+}
+
+// NewBernoulliSource creates a source emitting 1 with probability p and 0
+// otherwise, e.g. a request failing with probability p.
+// Panics if p is outside [0, 1].
+func NewBernoulliSource[T transform.Numeric](clk clock.Clock, p float64) *DistributionSource[T] {
+	if p < 0 || p > 1 {
+		panic("p outside [0, 1] for NewBernoulliSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		if rng.Float64() < p {
+			return 1
+		}
+		return 0
+	})
+}
+
+// NewZipfSource creates a source emitting ranks in [0, imax] following a
+// Zipf distribution, where rank k has probability proportional to
+// (v + k)^(-s). Rank 0 is the most popular, typical for key popularity.
+// Panics if s <= 1 or v < 1.
+func NewZipfSource[T transform.Numeric](clk clock.Clock, s, v float64, imax uint64) *DistributionSource[T] {
+	if s <= 1 || v < 1 {
+		panic("invalid parameter for NewZipfSource (requires s > 1, v >= 1)")
+	}
+	// Bound to the source RNG on first use; only the run goroutine samples
+	var zipf *rand.Zipf
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		if zipf == nil {
+			zipf = rand.NewZipf(rng, s, v, imax)
+		}
+		return float64(zipf.Uint64())
+	})
+}
+
+// poisson draws from a Poisson distribution using Knuth's multiplication
+// method for small lambda and Hörmann's PTRS rejection method otherwise.
+func poisson(rng *rand.Rand, lambda float64) float64 {
+	if lambda < 30 {
+		limit := math.Exp(-lambda)
+		k, prod := 0.0, rng.Float64()
+		for prod > limit {
+			k++
+			prod *= rng.Float64()
+		}
+		return k
+	}
+
+	slam := math.Sqrt(lambda)
+	loglam := math.Log(lambda)
+	b := 0.931 + 2.53*slam
+	a := -0.059 + 0.02483*b
+	invalpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+
+	for {
+		u := rng.Float64() - 0.5
+		v := rng.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+
+		if us >= 0.07 && v <= vr {
+			return k
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invalpha)-math.Log(a/(us*us)+b) <= -lambda+k*loglam-lg {
+			return k
+		}
+	}
+}
+
+// binomial draws from a binomial distribution by inversion for small means
+// and a clamped normal approximation for large ones.
+func binomial(rng *rand.Rand, n int, p float64) float64 {
+	// Sample the rarer outcome and mirror the result
+	q := min(p, 1-p)
+	nf := float64(n)
+
+	var k float64
+	if nf*q < 30 {
+		k = binomialInversion(rng, nf, q)
+	} else {
+		mean := nf * q
+		k = math.Round(mean + math.Sqrt(mean*(1-q))*rng.NormFloat64())
+		k = max(0, min(nf, k))
+	}
+
+	if q != p {
+		return nf - k
+	}
+	return k
+}
+
+// binomialInversion walks the cumulative distribution from zero.
+// Requires p <= 0.5.
+func binomialInversion(rng *rand.Rand, n, p float64) float64 {
+	if p == 0 {
+		return 0
+	}
+	q := 1 - p
+	qn := math.Exp(n * math.Log(q))
+	mean := n * p
+	bound := min(n, mean+10*math.Sqrt(mean*q+1))
+
+	k, px, u := 0.0, qn, rng.Float64()
+	for u > px {
+		k++
+		if k > bound {
+			// Floating-point drift in the tail, restart
+			k, px, u = 0, qn, rng.Float64()
+			continue
+		}
+		u -= px
+		px = ((n - k + 1) * p * px) / (k * q)
+	}
+	return k
+}
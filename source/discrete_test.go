@@ -0,0 +1,106 @@
+package source_test
+
+import (
+	"testing"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+)
+
+// TestDiscreteSource_Moments verifies mean and variance of the Poisson and
+// binomial samplers on both sides of their algorithm switch.
+func TestDiscreteSource_Moments(t *testing.T) {
+	tests := []struct {
+		name           string
+		newSource      func(clk clock.Clock) *source.DistributionSource[int]
+		mean, variance float64
+	}{
+		// Knuth below lambda 30, PTRS rejection above
+		{"Poisson small", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewPoissonSource[int](clk, 4)
+		}, 4, 4},
+		{"Poisson large", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewPoissonSource[int](clk, 100)
+		}, 100, 100},
+		// Inversion below n*min(p, 1-p) 30, normal approximation above
+		{"Binomial inversion", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBinomialSource[int](clk, 20, 0.3)
+		}, 6, 4.2},
+		{"Binomial inversion mirrored", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBinomialSource[int](clk, 20, 0.9)
+		}, 18, 1.8},
+		{"Binomial normal", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBinomialSource[int](clk, 1000, 0.5)
+		}, 500, 250},
+		{"Bernoulli", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBernoulliSource[int](clk, 0.3)
+		}, 0.3, 0.21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, variance := moments(sample(tt.newSource))
+			if !near(mean, tt.mean, 0.05) {
+				t.Errorf("mean = %v, want %v ±5%%", mean, tt.mean)
+			}
+			if !near(variance, tt.variance, 0.05) {
+				t.Errorf("variance = %v, want %v ±5%%", variance, tt.variance)
+			}
+		})
+	}
+}
+
+// TestDiscreteSource_Support verifies outputs stay within and reach their bounds.
+func TestDiscreteSource_Support(t *testing.T) {
+	tests := []struct {
+		name      string
+		newSource func(clk clock.Clock) *source.DistributionSource[int]
+		min, max  int
+	}{
+		{"Binomial p=0", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBinomialSource[int](clk, 50, 0)
+		}, 0, 0},
+		{"Binomial p=1", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBinomialSource[int](clk, 50, 1)
+		}, 50, 50},
+		{"Binomial", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBinomialSource[int](clk, 3, 0.5)
+		}, 0, 3},
+		{"Bernoulli", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewBernoulliSource[int](clk, 0.5)
+		}, 0, 1},
+		{"Zipf", func(clk clock.Clock) *source.DistributionSource[int] {
+			return source.NewZipfSource[int](clk, 1.5, 1, 10)
+		}, 0, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := make(map[int]int)
+			for _, v := range sample(tt.newSource) {
+				if v < tt.min || v > tt.max {
+					t.Fatalf("value %d outside [%d, %d]", v, tt.min, tt.max)
+				}
+				counts[v]++
+			}
+			if counts[tt.min] == 0 || counts[tt.max] == 0 {
+				t.Errorf("bounds not reached, counts = %v", counts)
+			}
+		})
+	}
+}
+
+// TestZipfSource_Popularity verifies rank 0 is the most frequent.
+func TestZipfSource_Popularity(t *testing.T) {
+	counts := make([]int, 11)
+	for _, v := range sample(func(clk clock.Clock) *source.DistributionSource[int] {
+		return source.NewZipfSource[int](clk, 1.5, 1, 10)
+	}) {
+		counts[v]++
+	}
+	for k := 1; k < len(counts); k++ {
+		if counts[k] > counts[0] {
+			t.Errorf("rank %d drawn %d times, more than rank 0 (%d)", k, counts[k], counts[0])
+		}
+	}
+}
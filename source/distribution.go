@@ -0,0 +1,83 @@
+package source
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/internal/numeric"
+	"github.com/neox5/simv/seed"
+	"github.com/neox5/simv/transform"
+)
+
+// DistributionSource generates values drawn from a probability distribution.
+// Samples are computed as float64; integer types round to the nearest value
+// and saturate at the bounds of T.
+type DistributionSource[T transform.Numeric] struct {
+	Broadcaster[T]
+}
+
+// newDistributionSource creates a source drawing from sample.
+// Uses the global seed registry for deterministic sequences when seeded.
+func newDistributionSource[T transform.Numeric](clk clock.Clock, sample func(rng *rand.Rand) float64) *DistributionSource[T] {
+	s := &DistributionSource[T]{}
+	rng := seed.NewRand()
+	conv := numeric.FromFloat[T]()
+	s.SetGenerator(clk, func(clock.Tick) T {
+		return conv(sample(rng))
+	})
+	return s
+}
+
+// NewLogNormalSource creates a source whose natural logarithm is normally
+// distributed with mean mu and standard deviation sigma.
+// The median of the output is exp(mu). Typical for request latencies.
+// Panics if sigma is negative.
+func NewLogNormalSource[T transform.Numeric](clk clock.Clock, mu, sigma float64) *DistributionSource[T] {
+	if sigma < 0 {
+		panic("negative sigma for NewLogNormalSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		return math.Exp(mu + sigma*rng.NormFloat64())
+	})
+}
+
+// NewParetoSource creates a source following a Pareto distribution with
+// minimum value scale and tail index shape. Smaller shapes produce heavier
+// tails; the mean is infinite for shape <= 1. Typical for payload sizes.
+// Panics if scale or shape is not positive.
+func NewParetoSource[T transform.Numeric](clk clock.Clock, scale, shape float64) *DistributionSource[T] {
+	if scale <= 0 || shape <= 0 {
+		panic("non-positive parameter for NewParetoSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		// 1-Float64() is in (0, 1], avoiding division by zero
+		return scale / math.Pow(1-rng.Float64(), 1/shape)
+	})
+}
+
+// NewExponentialSource creates a source following an exponential
+// distribution with the given rate (mean 1/rate). Typical for
+// inter-arrival times.
+// Panics if rate is not positive.
+func NewExponentialSource[T transform.Numeric](clk clock.Clock, rate float64) *DistributionSource[T] {
+	if rate <= 0 {
+		panic("non-positive rate for NewExponentialSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		return rng.ExpFloat64() / rate
+	})
+}
+
+// NewWeibullSource creates a source following a Weibull distribution with
+// the given scale and shape. Shape < 1 yields a heavy tail, shape 1 is
+// exponential. Typical for service and failure times.
+// Panics if scale or shape is not positive.
+func NewWeibullSource[T transform.Numeric](clk clock.Clock, scale, shape float64) *DistributionSource[T] {
+	if scale <= 0 || shape <= 0 {
+		panic("non-positive parameter for NewWeibullSource")
+	}
+	return newDistributionSource[T](clk, func(rng *rand.Rand) float64 {
+		return scale * math.Pow(-math.Log(1-rng.Float64()), 1/shape)
+	})
+}
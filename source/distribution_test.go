@@ -0,0 +1,105 @@
+package source_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+	"github.com/neox5/simv/transform"
+)
+
+// sampleCount is large enough for means within a few percent.
+const sampleCount = 20000
+
+// sample draws sampleCount values from the distribution source built by newSource.
+func sample[T transform.Numeric](newSource func(clk clock.Clock) *source.DistributionSource[T]) []T {
+	return collect(sampleCount, func(clk clock.Clock) source.Publisher[T] {
+		return newSource(clk)
+	})
+}
+
+// moments returns the sample mean and variance.
+func moments[T transform.Numeric](values []T) (mean, variance float64) {
+	for _, v := range values {
+		mean += float64(v)
+	}
+	mean /= float64(len(values))
+	for _, v := range values {
+		d := float64(v) - mean
+		variance += d * d
+	}
+	return mean, variance / float64(len(values)-1)
+}
+
+// near reports whether got is within relative tolerance tol of want.
+func near(got, want, tol float64) bool {
+	return math.Abs(got-want) <= tol*math.Abs(want)
+}
+
+// TestDistributionSource verifies support bounds and means of the
+// continuous distributions.
+func TestDistributionSource(t *testing.T) {
+	tests := []struct {
+		name      string
+		newSource func(clk clock.Clock) *source.DistributionSource[float64]
+		min       float64 // inclusive lower bound of the support
+		mean      float64
+	}{
+		{"LogNormal", func(clk clock.Clock) *source.DistributionSource[float64] {
+			return source.NewLogNormalSource[float64](clk, 0, 0.5)
+		}, 0, math.Exp(0.125)},
+		{"Pareto", func(clk clock.Clock) *source.DistributionSource[float64] {
+			return source.NewParetoSource[float64](clk, 2, 3)
+		}, 2, 3}, // shape*scale/(shape-1)
+		{"Exponential", func(clk clock.Clock) *source.DistributionSource[float64] {
+			return source.NewExponentialSource[float64](clk, 2)
+		}, 0, 0.5},
+		{"Weibull", func(clk clock.Clock) *source.DistributionSource[float64] {
+			return source.NewWeibullSource[float64](clk, 2, 1.5)
+		}, 0, 2 * math.Gamma(1+1/1.5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := sample(tt.newSource)
+			for _, v := range values {
+				if v < tt.min || math.IsNaN(v) || math.IsInf(v, 0) {
+					t.Fatalf("value %v outside support [%v, +Inf)", v, tt.min)
+				}
+			}
+			if mean, _ := moments(values); !near(mean, tt.mean, 0.05) {
+				t.Errorf("mean = %v, want %v ±5%%", mean, tt.mean)
+			}
+		})
+	}
+}
+
+// TestDistributionSource_IntegerSaturates verifies integer types round and
+// saturate instead of wrapping around.
+func TestDistributionSource_IntegerSaturates(t *testing.T) {
+	// Heavy tail: many draws exceed the range of uint8
+	values := sample(func(clk clock.Clock) *source.DistributionSource[uint8] {
+		return source.NewParetoSource[uint8](clk, 200, 0.5)
+	})
+	var saturated int
+	for _, v := range values {
+		if v < 200 {
+			t.Fatalf("value %d below Pareto scale 200", v)
+		}
+		if v == math.MaxUint8 {
+			saturated++
+		}
+	}
+	if saturated == 0 {
+		t.Error("no value saturated at 255")
+	}
+
+	for _, v := range sample(func(clk clock.Clock) *source.DistributionSource[int8] {
+		return source.NewLogNormalSource[int8](clk, 10, 0.1) // median e^10
+	}) {
+		if v != math.MaxInt8 {
+			t.Fatalf("value %d, want saturated %d", v, math.MaxInt8)
+		}
+	}
+}
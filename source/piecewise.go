@@ -0,0 +1,149 @@
+package source
+
+import (
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/internal/numeric"
+	"github.com/neox5/simv/transform"
+)
+
+type segmentKind int
+
+const (
+	segmentHold segmentKind = iota
+	segmentRamp
+	segmentHoldLast
+)
+
+// Segment is one piece of a PiecewiseSource schedule.
+// Create segments with Hold, RampTo and HoldLast.
+type Segment[T transform.Numeric] struct {
+	kind  segmentKind
+	value T
+	ticks int
+}
+
+// Hold emits value for the given number of ticks.
+// Panics if ticks is negative.
+func Hold[T transform.Numeric](value T, ticks int) Segment[T] {
+	if ticks < 0 {
+		panic("negative ticks for Hold")
+	}
+	return Segment[T]{kind: segmentHold, value: value, ticks: ticks}
+}
+
+// RampTo changes linearly from the previous segment's final value to value
+// over the given number of ticks, emitting value on the last tick.
+// A ramp with zero ticks is an instant jump that emits nothing.
+// Panics if ticks is negative.
+func RampTo[T transform.Numeric](value T, ticks int) Segment[T] {
+	if ticks < 0 {
+		panic("negative ticks for RampTo")
+	}
+	return Segment[T]{kind: segmentRamp, value: value, ticks: ticks}
+}
+
+// HoldLast keeps emitting the previous segment's final value for the given
+// number of ticks.
+// Panics if ticks is negative.
+func HoldLast[T transform.Numeric](ticks int) Segment[T] {
+	if ticks < 0 {
+		panic("negative ticks for HoldLast")
+	}
+	return Segment[T]{kind: segmentHoldLast, ticks: ticks}
+}
+
+// PiecewiseSource emits a scripted sequence of values defined by segments,
+// e.g. to reproduce the exact shape of an incident:
+//
+//	source.NewPiecewiseSource(clk,
+//		source.Hold(10, 30),      // 10 for 30 ticks
+//		source.RampTo(100, 60),   // linear ramp to 100 over 60 ticks
+//		source.HoldLast[int](20), // stay at 100
+//		source.Hold(0, 10),       // drop to 0
+//	)
+//
+// Segments before the first Hold start from the zero value of T. After the
+// last segment the final value is repeated, unless Loop was called.
+type PiecewiseSource[T transform.Numeric] struct {
+	Broadcaster[T]
+	segments []Segment[T]
+	conv     func(float64) T
+	loop     bool
+
+	// Playback position (owned by the generator goroutine)
+	index int
+	pos   int
+	start T // final value of the previous segment
+	last  T // most recently emitted value
+}
+
+// NewPiecewiseSource creates a source that plays back segments in order,
+// one value per clock tick.
+func NewPiecewiseSource[T transform.Numeric](clk clock.Clock, segments ...Segment[T]) *PiecewiseSource[T] {
+	s := &PiecewiseSource[T]{
+		segments: segments,
+		conv:     numeric.FromFloat[T](),
+	}
+	s.SetGenerator(clk, func(clock.Tick) T { return s.next() })
+	return s
+}
+
+// Loop restarts the schedule after the last segment instead of repeating
+// the final value. Ramps in the first segment continue from the final value
+// of the previous iteration.
+// Returns the source for method chaining.
+// Panics if called after the first subscription or if the schedule has no ticks.
+func (s *PiecewiseSource[T]) Loop() *PiecewiseSource[T] {
+	if s.hasSubscribed() {
+		panic("cannot enable loop after Subscribe()")
+	}
+	total := 0
+	for _, seg := range s.segments {
+		total += seg.ticks
+	}
+	if total == 0 {
+		panic("cannot loop schedule without ticks")
+	}
+	s.loop = true
+	return s
+}
+
+// next returns the value for the upcoming tick and advances the position.
+func (s *PiecewiseSource[T]) next() T {
+	for {
+		if s.index >= len(s.segments) {
+			if !s.loop {
+				return s.last
+			}
+			s.index = 0
+		}
+
+		seg := s.segments[s.index]
+		if s.pos < seg.ticks {
+			s.pos++
+			s.last = s.valueAt(seg, s.pos)
+			return s.last
+		}
+
+		// Segment exhausted, carry its final value into the next one
+		s.start = s.valueAt(seg, seg.ticks)
+		s.index++
+		s.pos = 0
+	}
+}
+
+// valueAt returns the value of seg on its pos-th tick (1-based).
+func (s *PiecewiseSource[T]) valueAt(seg Segment[T], pos int) T {
+	switch seg.kind {
+	case segmentHold:
+		return seg.value
+	case segmentRamp:
+		if pos >= seg.ticks {
+			return seg.value
+		}
+		from, to := float64(s.start), float64(seg.value)
+		return s.conv(from + (to-from)*float64(pos)/float64(seg.ticks))
+	default:
+		return s.start
+	}
+}
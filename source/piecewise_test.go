@@ -0,0 +1,72 @@
+package source_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
+)
+
+// TestPiecewiseSource verifies scripted playback of segments.
+func TestPiecewiseSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []source.Segment[int]
+		loop     bool
+		want     []int
+	}{
+		{
+			name: "hold, ramp, hold last, repeat final value",
+			segments: []source.Segment[int]{
+				source.Hold(5, 2), source.RampTo(9, 2), source.HoldLast[int](1), source.Hold(0, 1),
+			},
+			want: []int{5, 5, 7, 9, 9, 0, 0, 0},
+		},
+		{
+			name: "zero-tick ramp jumps",
+			segments: []source.Segment[int]{
+				source.Hold(1, 1), source.RampTo(10, 0), source.HoldLast[int](2), source.RampTo(20, 2),
+			},
+			want: []int{1, 10, 10, 15, 20, 20},
+		},
+		{
+			name:     "ramp starts from zero value",
+			segments: []source.Segment[int]{source.RampTo(8, 4)},
+			want:     []int{2, 4, 6, 8, 8},
+		},
+		{
+			name:     "loop continues ramp from previous iteration",
+			segments: []source.Segment[int]{source.RampTo(10, 2), source.Hold(4, 1)},
+			loop:     true,
+			want:     []int{5, 10, 4, 7, 10, 4, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := collect(len(tt.want), func(clk clock.Clock) source.Publisher[int] {
+				src := source.NewPiecewiseSource(clk, tt.segments...)
+				if tt.loop {
+					src.Loop()
+				}
+				return src
+			})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("values = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPiecewiseSource_LoopWithoutTicks verifies Loop rejects an empty schedule.
+func TestPiecewiseSource_LoopWithoutTicks(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Loop on schedule without ticks did not panic")
+		}
+	}()
+	clk := clock.NewPeriodicClock(time.Millisecond)
+	source.NewPiecewiseSource(clk, source.Hold(1, 0), source.RampTo(2, 0)).Loop()
+}
@@ -0,0 +1,96 @@
+package source
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/internal/numeric"
+	"github.com/neox5/simv/seed"
+	"github.com/neox5/simv/transform"
+)
+
+// RandomSource generates uniformly distributed values of any Numeric type.
+// Integer types are drawn from the inclusive range [min, max], floating-point
+// types from the half-open range [min, max).
+type RandomSource[T transform.Numeric] struct {
+	Broadcaster[T]
+	min, max T
+	rng      *rand.Rand
+}
+
+// NewRandomSource creates a source that generates uniform random values
+// between min and max. The full range of the type is supported, e.g.
+// NewRandomSource[uint64](clk, 0, math.MaxUint64).
+// Uses the global seed registry for deterministic sequences when seeded.
+// Panics if min > max or a float bound is infinite or NaN.
+func NewRandomSource[T transform.Numeric](clk clock.Clock, min, max T) *RandomSource[T] {
+	if min > max {
+		panic("min greater than max for NewRandomSource")
+	}
+	if numeric.IsFloat[T]() && !isFinite(float64(min), float64(max)) {
+		panic("non-finite bound for NewRandomSource")
+	}
+	s := &RandomSource[T]{
+		min: min,
+		max: max,
+		rng: seed.NewRand(),
+	}
+
+	next := s.nextUnsigned
+	switch {
+	case numeric.IsFloat[T]():
+		next = s.nextFloat
+	case numeric.IsSigned[T]():
+		next = s.nextSigned
+	}
+	s.SetGenerator(clk, func(clock.Tick) T { return next() })
+	return s
+}
+
+// nextSigned draws from [min, max] using two's complement arithmetic on
+// uint64, so max-min cannot overflow even for the full int64 range.
+func (s *RandomSource[T]) nextSigned() T {
+	lo := uint64(int64(s.min))
+	span := uint64(int64(s.max)) - lo
+	return T(int64(lo + s.offset(span)))
+}
+
+// nextUnsigned draws from [min, max].
+func (s *RandomSource[T]) nextUnsigned() T {
+	lo := uint64(s.min)
+	span := uint64(s.max) - lo
+	return T(lo + s.offset(span))
+}
+
+// offset returns a uniform value in [0, span].
+func (s *RandomSource[T]) offset(span uint64) uint64 {
+	if span == math.MaxUint64 {
+		return s.rng.Uint64()
+	}
+	return s.rng.Uint64N(span + 1)
+}
+
+// nextFloat draws from [min, max). Interpolating instead of computing
+// min + f*(max-min) avoids overflow to +Inf for ranges wider than MaxFloat64.
+// Results that round up to max (e.g. for float32 or f close to one) are
+// redrawn. Returns min if min == max.
+func (s *RandomSource[T]) nextFloat() T {
+	lo, hi := float64(s.min), float64(s.max)
+	for {
+		f := s.rng.Float64()
+		if v := T(lo*(1-f) + hi*f); v < s.max || s.min == s.max {
+			return v
+		}
+	}
+}
+
+// isFinite reports whether all values are neither infinite nor NaN.
+func isFinite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,135 @@
+package source_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/seed"
+	"github.com/neox5/simv/source"
+	"github.com/neox5/simv/transform"
+)
+
+func TestMain(m *testing.M) {
+	seed.Init(1)
+	m.Run()
+}
+
+// instantClock is a clock.Clock that emits ticks as fast as they are
+// received, so statistical tests can draw many samples quickly.
+type instantClock struct {
+	ticks chan clock.Tick
+	stop  chan struct{}
+	once  sync.Once
+}
+
+func newInstantClock() *instantClock {
+	return &instantClock{ticks: make(chan clock.Tick), stop: make(chan struct{})}
+}
+
+func (c *instantClock) Subscribe() <-chan clock.Tick { return c.ticks }
+func (c *instantClock) Pause()                       {}
+func (c *instantClock) Resume()                      {}
+func (c *instantClock) Stats() clock.ClockStats      { return clock.ClockStats{} }
+func (c *instantClock) Stop()                        { c.once.Do(func() { close(c.stop) }) }
+
+func (c *instantClock) Start() {
+	go func() {
+		defer close(c.ticks)
+		for seq := uint64(1); ; seq++ {
+			select {
+			case c.ticks <- clock.Tick{Seq: seq}:
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+}
+
+// collect subscribes to the source built by newSource on an instant clock
+// and returns its first n values.
+func collect[T any](n int, newSource func(clk clock.Clock) source.Publisher[T]) []T {
+	clk := newInstantClock()
+	src := newSource(clk)
+	samples := src.Subscribe()
+	clk.Start()
+	defer clk.Stop()
+	defer src.Unsubscribe(samples)
+
+	values := make([]T, n)
+	for i := range values {
+		values[i] = (<-samples).Value
+	}
+	return values
+}
+
+// draw collects n values from a RandomSource over [min, max].
+func draw[T transform.Numeric](min, max T, n int) []T {
+	return collect(n, func(clk clock.Clock) source.Publisher[T] {
+		return source.NewRandomSource(clk, min, max)
+	})
+}
+
+// checkRange verifies all values lie in [min, max] and that values below
+// low and above high were drawn, proving the whole range is covered.
+func checkRange[T transform.Numeric](t *testing.T, min, max, low, high T, n int) {
+	t.Helper()
+	var sawLow, sawHigh bool
+	for _, v := range draw(min, max, n) {
+		if v < min || v > max {
+			t.Fatalf("value %v outside [%v, %v]", v, min, max)
+		}
+		sawLow = sawLow || v <= low
+		sawHigh = sawHigh || v >= high
+	}
+	if !sawLow || !sawHigh {
+		t.Errorf("range not covered: saw <= %v %v, saw >= %v %v", low, sawLow, high, sawHigh)
+	}
+}
+
+// TestRandomSource_Bounds verifies full-range and degenerate bounds, where
+// max-min+1 overflows the type.
+func TestRandomSource_Bounds(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		checkRange[int8](t, math.MinInt8, math.MaxInt8, math.MinInt8, math.MaxInt8, 5000)
+	})
+	t.Run("uint8", func(t *testing.T) {
+		checkRange[uint8](t, 0, math.MaxUint8, 0, math.MaxUint8, 5000)
+	})
+	t.Run("int64", func(t *testing.T) {
+		checkRange[int64](t, math.MinInt64, math.MaxInt64, math.MinInt64/2, math.MaxInt64/2, 200)
+	})
+	t.Run("uint64", func(t *testing.T) {
+		checkRange[uint64](t, 0, math.MaxUint64, math.MaxUint64/4, math.MaxUint64/4*3, 200)
+	})
+	t.Run("min == max", func(t *testing.T) {
+		for _, v := range draw[int16](-7, -7, 50) {
+			if v != -7 {
+				t.Fatalf("value %d, want -7", v)
+			}
+		}
+	})
+	t.Run("non-finite float bounds", func(t *testing.T) {
+		for _, bounds := range [][2]float64{{0, math.Inf(1)}, {math.Inf(-1), 0}, {math.NaN(), 1}, {0, math.NaN()}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("NewRandomSource(%v, %v) did not panic", bounds[0], bounds[1])
+					}
+				}()
+				source.NewRandomSource(clock.NewPeriodicClock(time.Millisecond), bounds[0], bounds[1])
+			}()
+		}
+	})
+	t.Run("float32 excludes max", func(t *testing.T) {
+		// Every draw above the midpoint rounds to max in float32
+		max := math.Nextafter32(1, 2)
+		for _, v := range draw[float32](1, max, 200) {
+			if v != 1 {
+				t.Fatalf("value %v, want 1 (max %v is exclusive)", v, max)
+			}
+		}
+	})
+}
@@ -1,13 +1,29 @@
 package source
 
+import "github.com/neox5/simv/clock"
+
+// Sample is a generated value together with the tick that produced it.
+type Sample[T any] struct {
+	Value T
+	Tick  clock.Tick
+}
+
 // SourceStats contains observable metrics for a Source.
 type SourceStats struct {
 	GenerationCount uint64
 	SubscriberCount int
+	DroppedCount    uint64            // total samples dropped across all subscriptions
+	Subscribers     []SubscriberStats // per-subscription metrics in subscription order
 }
 
 // Publisher provides a subscription interface for typed values.
+// Embedding Broadcaster implements it.
 type Publisher[T any] interface {
-	Subscribe() <-chan T
+	// Subscribe returns an unbuffered, blocking subscription.
+	Subscribe() <-chan Sample[T]
+	// SubscribeWith returns a subscription using the given delivery policy.
+	SubscribeWith(d Delivery) <-chan Sample[T]
+	// Unsubscribe removes a subscription and closes its channel.
+	Unsubscribe(ch <-chan Sample[T])
 	Stats() SourceStats
 }
@@ -0,0 +1,199 @@
+package transform
+
+import (
+	"math"
+
+	"github.com/neox5/simv/internal/numeric"
+)
+
+// Clamp limits values to the inclusive range [min, max].
+type Clamp[T Numeric] struct {
+	min, max T
+}
+
+// NewClamp creates a transform that limits values to [min, max],
+// e.g. NewClamp(0.0, 100.0) for percentages.
+// Panics if min > max.
+func NewClamp[T Numeric](min, max T) *Clamp[T] {
+	if min > max {
+		panic("min greater than max for NewClamp")
+	}
+	return &Clamp[T]{min: min, max: max}
+}
+
+// Apply returns incoming limited to [min, max].
+func (t *Clamp[T]) Apply(incoming T, state State[T]) T {
+	return max(t.min, min(t.max, incoming))
+}
+
+// Name returns the transform identifier.
+func (t *Clamp[T]) Name() string {
+	return "Clamp"
+}
+
+// Scale applies a linear mapping incoming*factor + offset.
+type Scale[T Numeric] struct {
+	factor, offset float64
+	conv           func(float64) T
+}
+
+// NewScale creates a transform that computes incoming*factor + offset in
+// float64, e.g. NewScale[float64](1.0/1024, 0) to convert bytes to KiB.
+// Integer types round to the nearest value and saturate at the bounds of T.
+func NewScale[T Numeric](factor, offset float64) *Scale[T] {
+	return &Scale[T]{
+		factor: factor,
+		offset: offset,
+		conv:   numeric.FromFloat[T](),
+	}
+}
+
+// Apply returns incoming*factor + offset.
+func (t *Scale[T]) Apply(incoming T, state State[T]) T {
+	return t.conv(float64(incoming)*t.factor + t.offset)
+}
+
+// Name returns the transform identifier.
+func (t *Scale[T]) Name() string {
+	return "Scale"
+}
+
+// Offset adds a constant using the arithmetic of T.
+type Offset[T Numeric] struct {
+	offset T
+}
+
+// NewOffset creates a transform that adds offset to every value.
+// Unlike Scale, the addition is exact in T (and overflows like T does).
+func NewOffset[T Numeric](offset T) *Offset[T] {
+	return &Offset[T]{offset: offset}
+}
+
+// Apply returns incoming + offset.
+func (t *Offset[T]) Apply(incoming T, state State[T]) T {
+	return incoming + t.offset
+}
+
+// Name returns the transform identifier.
+func (t *Offset[T]) Name() string {
+	return "Offset"
+}
+
+// Quantize rounds values to the nearest multiple of a step.
+type Quantize[T Numeric] struct {
+	name  string
+	step  T
+	float bool
+	conv  func(float64) T
+}
+
+// NewQuantize creates a transform that rounds values to the nearest
+// multiple of step (halves away from zero), e.g. NewQuantize(0.25) or
+// NewQuantize(100) for coarse buckets.
+// Integer types use exact integer arithmetic and saturate at the bounds of T.
+// Panics if step is not positive.
+func NewQuantize[T Numeric](step T) *Quantize[T] {
+	if step <= 0 {
+		panic("non-positive step for NewQuantize")
+	}
+	return &Quantize[T]{
+		name:  "Quantize",
+		step:  step,
+		float: numeric.IsFloat[T](),
+		conv:  numeric.FromFloat[T](),
+	}
+}
+
+// NewRound creates a transform that rounds values to the nearest integer.
+// Integer values are returned unchanged.
+func NewRound[T Numeric]() *Quantize[T] {
+	q := NewQuantize[T](1)
+	q.name = "Round"
+	return q
+}
+
+// Apply returns incoming rounded to the nearest multiple of step.
+func (t *Quantize[T]) Apply(incoming T, state State[T]) T {
+	if t.float {
+		step := float64(t.step)
+		return t.conv(math.Round(float64(incoming)/step) * step)
+	}
+
+	if numeric.IsSigned[T]() {
+		return t.quantizeSigned(int64(incoming))
+	}
+	return t.quantizeUnsigned(uint64(incoming))
+}
+
+// quantizeSigned rounds x to a multiple of step in int64 and saturates at
+// the bounds of T.
+func (t *Quantize[T]) quantizeSigned(x int64) T {
+	step := int64(t.step)
+	hi := int64(numeric.IntMax[T]())
+	lo := -hi - 1
+
+	rem := x % step // sign follows x
+	down := x - rem
+	switch {
+	case rem > 0 && rem >= step-rem:
+		if down > hi-step {
+			return T(hi)
+		}
+		return T(down + step)
+	case rem < 0 && -rem >= step+rem:
+		if down < lo+step {
+			return T(lo)
+		}
+		return T(down - step)
+	default:
+		return T(down)
+	}
+}
+
+// quantizeUnsigned rounds x to a multiple of step in uint64 and saturates
+// at the maximum of T.
+func (t *Quantize[T]) quantizeUnsigned(x uint64) T {
+	step := uint64(t.step)
+	hi := uint64(numeric.IntMax[T]())
+
+	rem := x % step
+	down := x - rem
+	if rem > 0 && rem >= step-rem {
+		if down > hi-step {
+			return T(hi)
+		}
+		return T(down + step)
+	}
+	return T(down)
+}
+
+// Name returns the transform identifier.
+func (t *Quantize[T]) Name() string {
+	return t.name
+}
+
+// Abs returns the absolute value.
+type Abs[T Numeric] struct{}
+
+// NewAbs creates a transform that returns the absolute value.
+// The minimum of a signed integer type saturates to its maximum.
+func NewAbs[T Numeric]() *Abs[T] {
+	return &Abs[T]{}
+}
+
+// Apply returns the absolute value of incoming.
+func (t *Abs[T]) Apply(incoming T, state State[T]) T {
+	if incoming >= 0 || incoming != incoming { // NaN stays NaN
+		return incoming
+	}
+	if abs := -incoming; abs >= 0 {
+		return abs
+	}
+	// -MinInt overflows back to MinInt
+	return numeric.IntMax[T]()
+}
+
+// Name returns the transform identifier.
+func (t *Abs[T]) Name() string {
+	return "Abs"
+}
@@ -0,0 +1,39 @@
+package transform
+
+// Delay emits each value n ticks after it arrived, simulating a lagging
+// replica that follows a primary signal.
+// Keeps per-Value state; use one instance per Value.
+type Delay[T any] struct {
+	buf  []T // ring buffer of the last n inputs
+	next int // index of the oldest input, overwritten next
+}
+
+// NewDelay creates a transform that outputs the input from n ticks ago.
+// The first n ticks output fill. A delay of zero passes values through.
+// Panics if n is negative.
+func NewDelay[T any](n int, fill T) *Delay[T] {
+	if n < 0 {
+		panic("negative delay for NewDelay")
+	}
+	buf := make([]T, n)
+	for i := range buf {
+		buf[i] = fill
+	}
+	return &Delay[T]{buf: buf}
+}
+
+// Apply stores incoming and returns the input from n ticks ago.
+func (t *Delay[T]) Apply(incoming T, state State[T]) T {
+	if len(t.buf) == 0 {
+		return incoming
+	}
+	delayed := t.buf[t.next]
+	t.buf[t.next] = incoming
+	t.next = (t.next + 1) % len(t.buf)
+	return delayed
+}
+
+// Name returns the transform identifier.
+func (t *Delay[T]) Name() string {
+	return "Delay"
+}
@@ -0,0 +1,126 @@
+package transform
+
+import (
+	"math/rand/v2"
+
+	"github.com/neox5/simv/seed"
+)
+
+// Filter is a Transformation that can veto an update.
+// When Keep returns false the Value discards the sample: remaining
+// transforms are skipped, and neither state nor UpdateCount change.
+// Apply of a Filter passes values through unchanged.
+type Filter[T any] interface {
+	Transformation[T]
+	Keep(incoming T, state State[T]) bool
+}
+
+// Predicate keeps values for which a function returns true.
+type Predicate[T any] struct {
+	name string
+	keep func(incoming T, state State[T]) bool
+}
+
+// NewPredicate creates a filter named name that keeps values for which keep
+// returns true.
+func NewPredicate[T any](name string, keep func(incoming T, state State[T]) bool) *Predicate[T] {
+	return &Predicate[T]{name: name, keep: keep}
+}
+
+// NewThreshold creates a filter that keeps values in the inclusive range
+// [min, max] and skips all others, e.g. to only report when a value is
+// above an alerting threshold.
+// Panics if min > max.
+func NewThreshold[T Numeric](min, max T) *Predicate[T] {
+	if min > max {
+		panic("min greater than max for NewThreshold")
+	}
+	return NewPredicate("Threshold", func(incoming T, state State[T]) bool {
+		return incoming >= min && incoming <= max
+	})
+}
+
+// Keep reports whether the predicate accepts incoming.
+func (t *Predicate[T]) Keep(incoming T, state State[T]) bool {
+	return t.keep(incoming, state)
+}
+
+// Apply returns incoming unchanged.
+func (t *Predicate[T]) Apply(incoming T, state State[T]) T {
+	return incoming
+}
+
+// Name returns the transform identifier.
+func (t *Predicate[T]) Name() string {
+	return t.name
+}
+
+// SampleEvery keeps one out of every n values, simulating sparse reporting.
+// Keeps per-Value state; use one instance per Value.
+type SampleEvery[T any] struct {
+	n     uint64
+	count uint64
+}
+
+// NewSampleEvery creates a filter that keeps the first value and then every
+// n-th value after it.
+// Panics if n is zero.
+func NewSampleEvery[T any](n uint64) *SampleEvery[T] {
+	if n == 0 {
+		panic("zero n for NewSampleEvery")
+	}
+	return &SampleEvery[T]{n: n}
+}
+
+// Keep reports whether incoming is the n-th value since the last kept one.
+func (t *SampleEvery[T]) Keep(incoming T, state State[T]) bool {
+	keep := t.count%t.n == 0
+	t.count++
+	return keep
+}
+
+// Apply returns incoming unchanged.
+func (t *SampleEvery[T]) Apply(incoming T, state State[T]) T {
+	return incoming
+}
+
+// Name returns the transform identifier.
+func (t *SampleEvery[T]) Name() string {
+	return "SampleEvery"
+}
+
+// Drop randomly discards values, simulating lossy reporting.
+// Keeps per-Value state; use one instance per Value.
+type Drop[T any] struct {
+	probability float64
+	rng         *rand.Rand
+}
+
+// NewDrop creates a filter that discards each value with the given
+// probability. Uses the global seed registry for deterministic sequences
+// when seeded.
+// Panics if probability is outside [0, 1].
+func NewDrop[T any](probability float64) *Drop[T] {
+	if probability < 0 || probability > 1 {
+		panic("probability outside [0, 1] for NewDrop")
+	}
+	return &Drop[T]{
+		probability: probability,
+		rng:         seed.NewRand(),
+	}
+}
+
+// Keep reports whether incoming survives the random drop.
+func (t *Drop[T]) Keep(incoming T, state State[T]) bool {
+	return t.rng.Float64() >= t.probability
+}
+
+// Apply returns incoming unchanged.
+func (t *Drop[T]) Apply(incoming T, state State[T]) T {
+	return incoming
+}
+
+// Name returns the transform identifier.
+func (t *Drop[T]) Name() string {
+	return "Drop"
+}
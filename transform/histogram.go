@@ -0,0 +1,40 @@
+package transform
+
+import "github.com/neox5/simv/histogram"
+
+// Bucket accumulates observations into a histogram.
+// Incoming values are either single observations (histogram.Observation)
+// or bucketed histograms with the same bounds, which are merged.
+// Combine with Value.EnableResetOnRead(histogram.Histogram{}) to read
+// delta histograms.
+type Bucket struct {
+	empty histogram.Histogram
+}
+
+// NewBucket creates a transform that adds incoming observations to the
+// state, starting from an empty histogram with the given upper bounds
+// whenever the state is unbucketed (initially and after a reset).
+// Panics if bounds are not strictly ascending.
+func NewBucket(bounds ...float64) *Bucket {
+	return &Bucket{empty: histogram.New(bounds...)}
+}
+
+// Apply returns the state with incoming added.
+func (t *Bucket) Apply(incoming histogram.Histogram, state State[histogram.Histogram]) histogram.Histogram {
+	current := state.GetState()
+	if !current.Bucketed() {
+		current = t.empty
+	}
+	if incoming.Bucketed() {
+		return current.Merge(incoming)
+	}
+	if incoming.Count == 0 {
+		return current
+	}
+	return current.Observe(incoming.Sum)
+}
+
+// Name returns the transform identifier.
+func (t *Bucket) Name() string {
+	return "Bucket"
+}
@@ -0,0 +1,140 @@
+package transform
+
+import (
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/neox5/simv/internal/numeric"
+)
+
+// Hysteresis maps a numeric input to a discrete alert state.
+// A level is entered when the input reaches its rising threshold and left
+// when the input drops to its falling threshold, so noise between the two
+// does not flap the state. An optional minimum dwell time holds each state
+// for a while before the next change.
+// Keeps per-Value state; use one instance per Value.
+type Hysteresis[T Numeric] struct {
+	rising  []T // rising[i] enters level i+1 from level i
+	falling []T // falling[i] returns to level i from level i+1
+	outputs []T // output per level
+	dwell   time.Duration
+
+	level       int
+	lastChange  time.Time
+	changed     bool
+	transitions atomic.Uint64
+}
+
+// NewHysteresis creates a two-state transform that outputs 1 once the input
+// reaches rising and 0 once it drops to falling. It starts in state 0.
+// Panics if falling >= rising, as equal thresholds would flap.
+func NewHysteresis[T Numeric](rising, falling T) *Hysteresis[T] {
+	if falling >= rising {
+		panic("falling threshold not below rising for NewHysteresis")
+	}
+	return &Hysteresis[T]{
+		rising:  []T{rising},
+		falling: []T{falling},
+		outputs: []T{0, 1},
+	}
+}
+
+// NewAlertLevels creates a multi-level transform that outputs the index of
+// the highest threshold reached, e.g. 0 (ok), 1 (warning), 2 (critical) for
+// two thresholds. A level is left once the input drops to its threshold
+// minus band, clamped at the minimum of T.
+// Panics if no thresholds are given, thresholds are not strictly ascending,
+// band is not positive or a threshold minus band is not below the threshold
+// (a threshold at the minimum of T).
+func NewAlertLevels[T Numeric](band T, thresholds ...T) *Hysteresis[T] {
+	if len(thresholds) == 0 {
+		panic("no thresholds for NewAlertLevels")
+	}
+	if band <= 0 {
+		panic("non-positive band for NewAlertLevels")
+	}
+	for i := 1; i < len(thresholds); i++ {
+		if thresholds[i] <= thresholds[i-1] {
+			panic("thresholds not strictly ascending for NewAlertLevels")
+		}
+	}
+
+	h := &Hysteresis[T]{
+		rising:  slices.Clone(thresholds),
+		falling: make([]T, len(thresholds)),
+		outputs: make([]T, len(thresholds)+1),
+	}
+	for i, threshold := range thresholds {
+		h.falling[i] = threshold - band
+		if h.falling[i] > threshold {
+			// Integer underflow, clamp to the minimum of T
+			h.falling[i] = 0
+			if numeric.IsSigned[T]() {
+				h.falling[i] = -numeric.IntMax[T]() - 1
+			}
+		}
+		if h.falling[i] >= threshold {
+			panic("threshold at minimum of T for NewAlertLevels")
+		}
+	}
+	for i := range h.outputs {
+		h.outputs[i] = T(i)
+	}
+	return h
+}
+
+// Outputs sets the value emitted for each level, lowest level first.
+// Panics if the number of outputs does not match the number of levels.
+func (t *Hysteresis[T]) Outputs(outputs ...T) *Hysteresis[T] {
+	if len(outputs) != len(t.outputs) {
+		panic("output count does not match level count for Outputs")
+	}
+	t.outputs = slices.Clone(outputs)
+	return t
+}
+
+// MinDwell holds each state for at least d of simulated time (based on
+// scheduled tick timestamps) before allowing the next change.
+// Panics if d is negative.
+func (t *Hysteresis[T]) MinDwell(d time.Duration) *Hysteresis[T] {
+	if d < 0 {
+		panic("negative dwell for MinDwell")
+	}
+	t.dwell = d
+	return t
+}
+
+// Apply updates the level from incoming and returns its output.
+func (t *Hysteresis[T]) Apply(incoming T, state State[T]) T {
+	level := t.level
+	for level < len(t.rising) && incoming >= t.rising[level] {
+		level++
+	}
+	if level == t.level {
+		for level > 0 && incoming <= t.falling[level-1] {
+			level--
+		}
+	}
+
+	if level != t.level {
+		now := state.Tick().Scheduled
+		if !t.changed || now.Sub(t.lastChange) >= t.dwell {
+			t.level = level
+			t.lastChange = now
+			t.changed = true
+			t.transitions.Add(1)
+		}
+	}
+	return t.outputs[t.level]
+}
+
+// Name returns the transform identifier.
+func (t *Hysteresis[T]) Name() string {
+	return "Hysteresis"
+}
+
+// Transitions returns the number of state changes.
+func (t *Hysteresis[T]) Transitions() uint64 {
+	return t.transitions.Load()
+}
@@ -0,0 +1,77 @@
+package transform
+
+import (
+	"math/rand/v2"
+
+	"github.com/neox5/simv/internal/numeric"
+	"github.com/neox5/simv/seed"
+)
+
+// Noise adds seeded random noise to values passing through, e.g. to make a
+// deterministic waveform or schedule look like a real measurement.
+// Noise is computed as float64; integer types round to the nearest value
+// and saturate at the bounds of T.
+// Draws from its own RNG; use one instance per Value.
+type Noise[T Numeric] struct {
+	name  string
+	apply func(rng *rand.Rand, v float64) float64
+	rng   *rand.Rand
+	conv  func(float64) T
+}
+
+// newNoise creates a noise transform using apply.
+// Uses the global seed registry for deterministic sequences when seeded.
+func newNoise[T Numeric](name string, apply func(rng *rand.Rand, v float64) float64) *Noise[T] {
+	return &Noise[T]{
+		name:  name,
+		apply: apply,
+		rng:   seed.NewRand(),
+		conv:  numeric.FromFloat[T](),
+	}
+}
+
+// NewUniformNoise creates a transform that adds noise drawn uniformly from
+// [-amplitude, amplitude).
+// Panics if amplitude is negative.
+func NewUniformNoise[T Numeric](amplitude float64) *Noise[T] {
+	if amplitude < 0 {
+		panic("negative amplitude for NewUniformNoise")
+	}
+	return newNoise[T]("UniformNoise", func(rng *rand.Rand, v float64) float64 {
+		return v + (rng.Float64()*2-1)*amplitude
+	})
+}
+
+// NewGaussianNoise creates a transform that adds normally distributed noise
+// with mean zero and standard deviation stddev.
+// Panics if stddev is negative.
+func NewGaussianNoise[T Numeric](stddev float64) *Noise[T] {
+	if stddev < 0 {
+		panic("negative stddev for NewGaussianNoise")
+	}
+	return newNoise[T]("GaussianNoise", func(rng *rand.Rand, v float64) float64 {
+		return v + rng.NormFloat64()*stddev
+	})
+}
+
+// NewPercentNoise creates a transform that scales values by a random factor
+// within ±percent, so the noise grows with the signal (e.g. 5 for ±5%).
+// Panics if percent is negative.
+func NewPercentNoise[T Numeric](percent float64) *Noise[T] {
+	if percent < 0 {
+		panic("negative percent for NewPercentNoise")
+	}
+	return newNoise[T]("PercentNoise", func(rng *rand.Rand, v float64) float64 {
+		return v * (1 + (rng.Float64()*2-1)*percent/100)
+	})
+}
+
+// Apply returns incoming with noise added.
+func (t *Noise[T]) Apply(incoming T, state State[T]) T {
+	return t.conv(t.apply(t.rng, float64(incoming)))
+}
+
+// Name returns the transform identifier.
+func (t *Noise[T]) Name() string {
+	return t.name
+}
@@ -0,0 +1,105 @@
+package transform
+
+// Composite is a Transformation made of inner steps.
+// A Value expands composites in place: each step is applied and reported
+// to hooks individually, and a Filter step can veto the whole update.
+// Apply runs all steps directly, so filters inside only pass values through.
+type Composite[T any] interface {
+	Transformation[T]
+	// Steps returns the steps to apply to incoming, in order.
+	Steps(incoming T, state State[T]) []Transformation[T]
+}
+
+// Pipeline is a named sequence of transforms applied as one.
+// A Pipeline shares its steps; one with stateful steps must not be added
+// to more than one Value, use DefinePipeline to build one per Value.
+type Pipeline[T any] struct {
+	name  string
+	steps []Transformation[T]
+}
+
+// NewPipeline creates a composite transform applying steps in order.
+// Hooks report inner steps as "name/Step".
+func NewPipeline[T any](name string, steps ...Transformation[T]) *Pipeline[T] {
+	return &Pipeline[T]{name: name, steps: steps}
+}
+
+// DefinePipeline returns a constructor for pipelines named name whose steps
+// are created by build, so each Value gets fresh stateful steps:
+//
+//	newLatency := transform.DefinePipeline("Latency", func() []transform.Transformation[float64] {
+//		return []transform.Transformation[float64]{
+//			transform.NewGaussianNoise[float64](2),
+//			transform.NewTimeWindow[float64](time.Minute, transform.Quantile(0.95)),
+//		}
+//	})
+//	val := value.New(src).AddTransform(newLatency())
+func DefinePipeline[T any](name string, build func() []Transformation[T]) func() *Pipeline[T] {
+	return func() *Pipeline[T] {
+		return NewPipeline(name, build()...)
+	}
+}
+
+// Steps returns the pipeline steps.
+func (t *Pipeline[T]) Steps(incoming T, state State[T]) []Transformation[T] {
+	return t.steps
+}
+
+// Apply runs incoming through all steps.
+func (t *Pipeline[T]) Apply(incoming T, state State[T]) T {
+	return applySteps(t.steps, incoming, state)
+}
+
+// Name returns the pipeline name.
+func (t *Pipeline[T]) Name() string {
+	return t.name
+}
+
+// Conditional applies one of two transforms depending on a condition.
+type Conditional[T any] struct {
+	cond Filter[T]
+	then Transformation[T]
+	els  Transformation[T]
+}
+
+// If creates a composite transform that applies then when cond keeps the
+// incoming value and els otherwise. A nil branch passes values through.
+// cond only selects the branch, it never vetoes the update. Any Filter
+// works as condition, e.g. NewThreshold or NewPredicate.
+// Hooks report branch steps as "If(cond)/Step".
+// Panics if cond is nil.
+func If[T any](cond Filter[T], then, els Transformation[T]) *Conditional[T] {
+	if cond == nil {
+		panic("nil condition for If")
+	}
+	return &Conditional[T]{cond: cond, then: then, els: els}
+}
+
+// Steps evaluates the condition and returns the selected branch.
+func (t *Conditional[T]) Steps(incoming T, state State[T]) []Transformation[T] {
+	branch := t.els
+	if t.cond.Keep(incoming, state) {
+		branch = t.then
+	}
+	if branch == nil {
+		return nil
+	}
+	return []Transformation[T]{branch}
+}
+
+// Apply runs incoming through the selected branch.
+func (t *Conditional[T]) Apply(incoming T, state State[T]) T {
+	return applySteps(t.Steps(incoming, state), incoming, state)
+}
+
+// Name returns the transform identifier, e.g. "If(Threshold)".
+func (t *Conditional[T]) Name() string {
+	return "If(" + t.cond.Name() + ")"
+}
+
+func applySteps[T any](steps []Transformation[T], incoming T, state State[T]) T {
+	for _, step := range steps {
+		incoming = step.Apply(incoming, state)
+	}
+	return incoming
+}
@@ -0,0 +1,100 @@
+package transform
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/neox5/simv/internal/numeric"
+)
+
+// counterTracker remembers the previous cumulative input and detects resets.
+type counterTracker[T Numeric] struct {
+	prev     T
+	prevTime time.Time
+	hasPrev  bool
+	resets   atomic.Uint64
+}
+
+// observe records incoming and returns the increase since the previous
+// input. A decrease is treated as a counter reset: the counter restarted
+// from zero, so the increase is incoming itself. The first input has no
+// baseline and yields zero.
+func (c *counterTracker[T]) observe(incoming T, at time.Time) (delta T, elapsed time.Duration, ok bool) {
+	prev, prevTime, hasPrev := c.prev, c.prevTime, c.hasPrev
+	c.prev, c.prevTime, c.hasPrev = incoming, at, true
+
+	if !hasPrev {
+		return 0, 0, false
+	}
+	if incoming < prev {
+		c.resets.Add(1)
+		return incoming, at.Sub(prevTime), true
+	}
+	return incoming - prev, at.Sub(prevTime), true
+}
+
+// Delta converts a cumulative counter into per-tick increases.
+// Keeps per-Value state; use one instance per Value.
+type Delta[T Numeric] struct {
+	tracker counterTracker[T]
+}
+
+// NewDelta creates a transform that outputs the increase of a cumulative
+// input since the previous tick. The first tick outputs zero. A decrease is
+// treated as a counter reset and outputs the new input value.
+func NewDelta[T Numeric]() *Delta[T] {
+	return &Delta[T]{}
+}
+
+// Apply returns the increase since the previous input.
+func (t *Delta[T]) Apply(incoming T, state State[T]) T {
+	delta, _, _ := t.tracker.observe(incoming, state.Tick().Scheduled)
+	return delta
+}
+
+// Name returns the transform identifier.
+func (t *Delta[T]) Name() string {
+	return "Delta"
+}
+
+// Resets returns the number of counter resets detected.
+func (t *Delta[T]) Resets() uint64 {
+	return t.tracker.resets.Load()
+}
+
+// Rate converts a cumulative counter into a per-second rate using the
+// scheduled timestamps of consecutive ticks.
+// Keeps per-Value state; use one instance per Value.
+type Rate[T Numeric] struct {
+	tracker counterTracker[T]
+	conv    func(float64) T
+}
+
+// NewRate creates a transform that outputs the per-second increase of a
+// cumulative input between consecutive ticks. The first tick, and ticks
+// without elapsed time, output zero. Counter resets are handled as in Delta.
+// Integer types round to the nearest value.
+func NewRate[T Numeric]() *Rate[T] {
+	return &Rate[T]{
+		conv: numeric.FromFloat[T](),
+	}
+}
+
+// Apply returns the per-second increase since the previous input.
+func (t *Rate[T]) Apply(incoming T, state State[T]) T {
+	delta, elapsed, ok := t.tracker.observe(incoming, state.Tick().Scheduled)
+	if !ok || elapsed <= 0 {
+		return 0
+	}
+	return t.conv(float64(delta) / elapsed.Seconds())
+}
+
+// Name returns the transform identifier.
+func (t *Rate[T]) Name() string {
+	return "Rate"
+}
+
+// Resets returns the number of counter resets detected.
+func (t *Rate[T]) Resets() uint64 {
+	return t.tracker.resets.Load()
+}
@@ -1,8 +1,25 @@
 package transform
 
+import (
+	"time"
+
+	"github.com/neox5/simv/clock"
+)
+
 // State provides read-only access to current state.
 type State[T any] interface {
 	GetState() T
+	// Tick returns the tick of the sample being processed.
+	Tick() clock.Tick
+}
+
+// tickTime returns the scheduled time of the tick being processed, or the
+// current time for samples without a tick (e.g. from custom publishers).
+func tickTime[T any](state State[T]) time.Time {
+	if at := state.Tick().Scheduled; !at.IsZero() {
+		return at
+	}
+	return time.Now()
 }
 
 // Transformation modifies a value.
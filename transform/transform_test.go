@@ -0,0 +1,259 @@
+package transform_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/seed"
+	"github.com/neox5/simv/transform"
+)
+
+func TestMain(m *testing.M) {
+	seed.Init(1)
+	m.Run()
+}
+
+// testState is a minimal transform.State for driving transforms directly.
+type testState[T any] struct {
+	state T
+	tick  clock.Tick
+}
+
+func (s *testState[T]) GetState() T      { return s.state }
+func (s *testState[T]) Tick() clock.Tick { return s.tick }
+
+// run feeds inputs through t as a Value would, one tick per interval,
+// and returns the outputs.
+func run[T any](t transform.Transformation[T], interval time.Duration, inputs ...T) []T {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := &testState[T]{}
+
+	outputs := make([]T, 0, len(inputs))
+	for i, in := range inputs {
+		state.tick = clock.Tick{
+			Seq:       uint64(i + 1),
+			Scheduled: start.Add(time.Duration(i) * interval),
+		}
+		state.state = t.Apply(in, state)
+		outputs = append(outputs, state.state)
+	}
+	return outputs
+}
+
+// TestDelta verifies per-tick increases with counter reset detection.
+func TestDelta(t *testing.T) {
+	delta := transform.NewDelta[int]()
+	got := run(delta, time.Second, 10, 15, 15, 22, 3, 8)
+
+	if want := []int{0, 5, 0, 7, 3, 5}; !slices.Equal(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+	if got := delta.Resets(); got != 1 {
+		t.Errorf("Resets() = %d, want 1", got)
+	}
+}
+
+// TestRate verifies per-second rates derived from tick timestamps.
+func TestRate(t *testing.T) {
+	rate := transform.NewRate[float64]()
+	got := run(rate, 500*time.Millisecond, 0, 10, 30, 5)
+
+	if want := []float64{0, 20, 40, 10}; !slices.Equal(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+}
+
+// TestArithmetic verifies the stateless shaping transforms.
+func TestArithmetic(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform transform.Transformation[int8]
+		inputs    []int8
+		want      []int8
+	}{
+		{"Clamp", transform.NewClamp[int8](0, 100), []int8{-5, 50, 127}, []int8{0, 50, 100}},
+		{"Scale", transform.NewScale[int8](2, 1), []int8{3, -3, 100}, []int8{7, -5, 127}},
+		{"Offset", transform.NewOffset[int8](-10), []int8{15, 0}, []int8{5, -10}},
+		{"Quantize", transform.NewQuantize[int8](5), []int8{7, 8, -12}, []int8{5, 10, -10}},
+		{"QuantizeSaturates", transform.NewQuantize[int8](50), []int8{127, 120, -127}, []int8{127, 100, -128}},
+		{"Round", transform.NewRound[int8](), []int8{127, -128}, []int8{127, -128}},
+		{"Abs", transform.NewAbs[int8](), []int8{-7, 7, -128}, []int8{7, 7, 127}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.transform, time.Second, tt.inputs...); !slices.Equal(got, tt.want) {
+				t.Errorf("outputs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestQuantize_Exact verifies integer quantization does not lose precision
+// through float64.
+func TestQuantize_Exact(t *testing.T) {
+	const big = 1<<53 + 1 // not representable as float64
+
+	if got := run(transform.NewRound[int64](), time.Second, big); got[0] != big {
+		t.Errorf("Round(%d) = %d", int64(big), got[0])
+	}
+	if got := run(transform.NewQuantize[uint64](10), time.Second, big); got[0] != 9007199254740990 {
+		t.Errorf("Quantize(10)(%d) = %d, want 9007199254740990", uint64(big), got[0])
+	}
+	if got := run(transform.NewQuantize[uint8](100), time.Second, 250); got[0] != 255 {
+		t.Errorf("Quantize(100)(250) = %d, want saturated 255", got[0])
+	}
+}
+
+// TestWindow verifies count-based and time-based window aggregations.
+func TestWindow(t *testing.T) {
+	inputs := []int{4, 1, 9, 3, 7}
+	tests := []struct {
+		name      string
+		transform transform.Transformation[int]
+		want      []int
+	}{
+		{"Min", transform.NewWindow[int](3, transform.Min()), []int{4, 1, 1, 1, 3}},
+		{"Max", transform.NewWindow[int](3, transform.Max()), []int{4, 4, 9, 9, 9}},
+		{"Sum", transform.NewWindow[int](2, transform.Sum()), []int{4, 5, 10, 12, 10}},
+		{"Count", transform.NewWindow[int](3, transform.Count()), []int{1, 2, 3, 3, 3}},
+		{"Median", transform.NewWindow[int](3, transform.Quantile(0.5)), []int{4, 3, 4, 3, 7}},
+		// One tick per second: a 2s span covers the incoming and the previous input
+		{"TimeSum", transform.NewTimeWindow[int](2*time.Second, transform.Sum()), []int{4, 5, 10, 12, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.transform, time.Second, inputs...); !slices.Equal(got, tt.want) {
+				t.Errorf("outputs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	p95 := transform.NewWindow[float64](5, transform.Quantile(0.95))
+	got := run(p95, time.Second, 10, 20, 30, 40, 50)
+	if want := 48.0; got[4] != want {
+		t.Errorf("p95 = %v, want %v", got[4], want)
+	}
+	if name := p95.Name(); name != "Window(P95, 5)" {
+		t.Errorf("Name() = %q, want %q", name, "Window(P95, 5)")
+	}
+}
+
+// TestTimeWindow_WithoutTick verifies samples without a tick are timed on
+// receipt, so the window still evicts.
+func TestTimeWindow_WithoutTick(t *testing.T) {
+	window := transform.NewTimeWindow[int](10*time.Millisecond, transform.Count())
+	state := &testState[int]{} // zero tick
+
+	for range 3 {
+		if got := window.Apply(1, state); got != 1 {
+			t.Errorf("Count = %d, want 1", got)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// TestHysteresis verifies separate rising/falling thresholds and dwell time.
+func TestHysteresis(t *testing.T) {
+	inputs := []int{50, 85, 75, 85, 65, 95, 85, 50}
+
+	alert := transform.NewHysteresis(80, 70)
+	if want, got := []int{0, 1, 1, 1, 0, 1, 1, 0}, run(alert, time.Second, inputs...); !slices.Equal(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+	if got := alert.Transitions(); got != 4 {
+		t.Errorf("Transitions() = %d, want 4", got)
+	}
+
+	dwell := transform.NewHysteresis(80, 70).MinDwell(3 * time.Second)
+	if want, got := []int{0, 1, 1, 1, 0, 0, 0, 1}, run(dwell, time.Second, 50, 85, 65, 65, 65, 85, 85, 85); !slices.Equal(got, want) {
+		t.Errorf("dwell outputs = %v, want %v", got, want)
+	}
+
+	// Band wider than the threshold must not underflow for unsigned types
+	unsigned := transform.NewAlertLevels[uint](10, 5, 50)
+	if want, got := []uint{1, 1, 1, 0}, run(unsigned, time.Second, 6, 6, 6, 0); !slices.Equal(got, want) {
+		t.Errorf("unsigned outputs = %v, want %v", got, want)
+	}
+
+	levels := transform.NewAlertLevels(5, 80, 90).Outputs(0, 10, 20)
+	if want, got := []int{0, 10, 10, 20, 20, 10, 0}, run(levels, time.Second, 50, 85, 76, 92, 86, 84, 70); !slices.Equal(got, want) {
+		t.Errorf("levels outputs = %v, want %v", got, want)
+	}
+
+	// Equal thresholds would flap on a constant input at the threshold
+	for name, create := range map[string]func(){
+		"NewHysteresis(80, 80)":       func() { transform.NewHysteresis(80, 80) },
+		"NewAlertLevels(0, 80)":       func() { transform.NewAlertLevels(0, 80) },
+		"NewAlertLevels[uint](5, 0)":  func() { transform.NewAlertLevels[uint](5, 0) },
+		"NewAlertLevels(1e-20, 80.0)": func() { transform.NewAlertLevels(1e-20, 80.0) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic", name)
+				}
+			}()
+			create()
+		}()
+	}
+}
+
+// TestNoise verifies noise stays within its bounds and varies.
+func TestNoise(t *testing.T) {
+	inputs := slices.Repeat([]float64{100}, 1000)
+
+	tests := []struct {
+		name     string
+		noise    transform.Transformation[float64]
+		min, max float64
+	}{
+		{"Uniform", transform.NewUniformNoise[float64](2), 98, 102},
+		{"Percent", transform.NewPercentNoise[float64](5), 95, 105},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := run(tt.noise, time.Second, inputs...)
+			if lo, hi := slices.Min(got), slices.Max(got); lo < tt.min || hi > tt.max || lo == hi {
+				t.Errorf("outputs in [%v, %v], want varying within [%v, %v]", lo, hi, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+// TestDelay verifies values are emitted n ticks late after the fill value.
+func TestDelay(t *testing.T) {
+	if want, got := []int{-1, -1, 1, 2, 3}, run(transform.NewDelay(2, -1), time.Second, 1, 2, 3, 4, 5); !slices.Equal(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+	if want, got := []int{1, 2}, run(transform.NewDelay(0, -1), time.Second, 1, 2); !slices.Equal(got, want) {
+		t.Errorf("zero delay outputs = %v, want %v", got, want)
+	}
+}
+
+// TestWrapAccumulate verifies wrapping at the modulus and the wrap count.
+func TestWrapAccumulate(t *testing.T) {
+	wrap := transform.NewWrapAccumulate[uint8](100)
+	got := run(wrap, time.Second, 60, 30, 20, 250, 0)
+
+	if want := []uint8{60, 90, 10, 60, 60}; !slices.Equal(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+	if got := wrap.Wraps(); got != 3 {
+		t.Errorf("Wraps() = %d, want 3", got)
+	}
+
+	int8Default := transform.NewWrapAccumulate[int8](0)
+	if want, got := []int8{100, 72}, run(int8Default, time.Second, 100, 100); !slices.Equal(got, want) {
+		t.Errorf("int8 default outputs = %v, want %v", got, want)
+	}
+
+	full := transform.NewWrapAccumulate[uint8](256)
+	if want, got := []uint8{200, 144}, run(full, time.Second, 200, 200); !slices.Equal(got, want) {
+		t.Errorf("full range outputs = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,182 @@
+package transform
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/neox5/simv/internal/numeric"
+)
+
+// Aggregation summarizes the values in a window.
+type Aggregation struct {
+	name string
+	q    float64 // only for quantiles
+	kind aggregationKind
+}
+
+type aggregationKind int
+
+const (
+	aggMin aggregationKind = iota
+	aggMax
+	aggSum
+	aggCount
+	aggQuantile
+)
+
+// Min aggregates to the smallest value in the window.
+func Min() Aggregation { return Aggregation{name: "Min", kind: aggMin} }
+
+// Max aggregates to the largest value in the window.
+func Max() Aggregation { return Aggregation{name: "Max", kind: aggMax} }
+
+// Sum aggregates to the sum of the values in the window.
+func Sum() Aggregation { return Aggregation{name: "Sum", kind: aggSum} }
+
+// Count aggregates to the number of values in the window.
+func Count() Aggregation { return Aggregation{name: "Count", kind: aggCount} }
+
+// Quantile aggregates to the q-quantile of the values in the window,
+// linearly interpolated between the closest ranks (q=0.95 for p95).
+// Panics if q is outside [0, 1].
+func Quantile(q float64) Aggregation {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		panic("quantile outside [0, 1] for Quantile")
+	}
+	return Aggregation{
+		name: "P" + strconv.FormatFloat(q*100, 'f', -1, 64),
+		q:    q,
+		kind: aggQuantile,
+	}
+}
+
+// String returns the aggregation name, e.g. "Max" or "P95".
+func (a Aggregation) String() string {
+	return a.name
+}
+
+type windowEntry[T any] struct {
+	value T
+	at    time.Time
+}
+
+// Window aggregates recent inputs over a count-based or time-based window.
+// Keeps per-Value state; use one instance per Value.
+type Window[T Numeric] struct {
+	agg    Aggregation
+	size   int           // count-based window, zero if time-based
+	span   time.Duration // time-based window, zero if count-based
+	conv   func(float64) T
+	buf    []windowEntry[T]
+	sorted []T // scratch space for quantiles
+}
+
+// NewWindow creates a transform that outputs agg over the last size inputs,
+// including the incoming one. Until size inputs were seen the window covers
+// all inputs so far.
+// Panics if size is not positive.
+func NewWindow[T Numeric](size int, agg Aggregation) *Window[T] {
+	if size <= 0 {
+		panic("non-positive size for NewWindow")
+	}
+	return newWindow[T](size, 0, agg)
+}
+
+// NewTimeWindow creates a transform that outputs agg over the inputs whose
+// tick was scheduled within span of the incoming tick, e.g. p95 latency
+// over the last minute. Timestamps follow the simulated timeline; samples
+// without a tick are timed on receipt.
+// Panics if span is not positive.
+func NewTimeWindow[T Numeric](span time.Duration, agg Aggregation) *Window[T] {
+	if span <= 0 {
+		panic("non-positive span for NewTimeWindow")
+	}
+	return newWindow[T](0, span, agg)
+}
+
+func newWindow[T Numeric](size int, span time.Duration, agg Aggregation) *Window[T] {
+	return &Window[T]{
+		agg:  agg,
+		size: size,
+		span: span,
+		conv: numeric.FromFloat[T](),
+	}
+}
+
+// Apply adds incoming to the window and returns the aggregate.
+func (t *Window[T]) Apply(incoming T, state State[T]) T {
+	at := tickTime(state)
+	t.buf = append(t.buf, windowEntry[T]{value: incoming, at: at})
+	t.evict(at)
+	return t.aggregate()
+}
+
+// evict drops entries that fell out of the window.
+func (t *Window[T]) evict(now time.Time) {
+	drop := 0
+	if t.size > 0 {
+		drop = max(len(t.buf)-t.size, 0)
+	} else {
+		for drop < len(t.buf) && now.Sub(t.buf[drop].at) >= t.span {
+			drop++
+		}
+	}
+	if drop > 0 {
+		t.buf = append(t.buf[:0], t.buf[drop:]...)
+	}
+}
+
+func (t *Window[T]) aggregate() T {
+	switch t.agg.kind {
+	case aggMin:
+		result := t.buf[0].value
+		for _, e := range t.buf[1:] {
+			result = min(result, e.value)
+		}
+		return result
+	case aggMax:
+		result := t.buf[0].value
+		for _, e := range t.buf[1:] {
+			result = max(result, e.value)
+		}
+		return result
+	case aggSum:
+		var result T
+		for _, e := range t.buf {
+			result += e.value
+		}
+		return result
+	case aggCount:
+		return T(len(t.buf))
+	default:
+		return t.quantile()
+	}
+}
+
+func (t *Window[T]) quantile() T {
+	t.sorted = t.sorted[:0]
+	for _, e := range t.buf {
+		t.sorted = append(t.sorted, e.value)
+	}
+	slices.Sort(t.sorted)
+
+	rank := t.agg.q * float64(len(t.sorted)-1)
+	lo := int(rank)
+	if lo == len(t.sorted)-1 {
+		return t.sorted[lo]
+	}
+	frac := rank - float64(lo)
+	a, b := float64(t.sorted[lo]), float64(t.sorted[lo+1])
+	return t.conv(a + (b-a)*frac)
+}
+
+// Name returns the transform identifier, e.g. "Window(P95, 1m0s)".
+func (t *Window[T]) Name() string {
+	if t.size > 0 {
+		return fmt.Sprintf("Window(%s, %d)", t.agg, t.size)
+	}
+	return fmt.Sprintf("Window(%s, %s)", t.agg, t.span)
+}
@@ -0,0 +1,67 @@
+package transform
+
+import (
+	"sync/atomic"
+
+	"github.com/neox5/simv/internal/numeric"
+)
+
+// WrapAccumulate adds each value to a running total that wraps at a
+// modulus, like an SNMP Counter32, instead of silently overflowing T.
+// Negative values are ignored; counters only increase.
+type WrapAccumulate[T Numeric] struct {
+	modulus uint64
+	wraps   atomic.Uint64
+}
+
+// NewWrapAccumulate creates a transform that accumulates values modulo
+// modulus, e.g. 1<<32 for Counter32. Zero wraps at the range of T like
+// CounterSource does (e.g. 2^7 for int8, 2^64 for uint64) and disables
+// wrapping for float types.
+// Panics if modulus exceeds the range of an integer T.
+func NewWrapAccumulate[T Numeric](modulus uint64) *WrapAccumulate[T] {
+	if modulus == 0 {
+		if !numeric.IsFloat[T]() {
+			modulus = uint64(numeric.IntMax[T]()) + 1 // 0 for uint64: 2^64
+		}
+	} else {
+		numeric.CheckModulus[T](modulus, "modulus exceeds range of T for NewWrapAccumulate")
+	}
+	return &WrapAccumulate[T]{modulus: modulus}
+}
+
+// Apply adds incoming to the current state, wrapping at the modulus.
+func (t *WrapAccumulate[T]) Apply(incoming T, state State[T]) T {
+	current := state.GetState()
+	if incoming <= 0 {
+		return current
+	}
+
+	var wraps uint64
+	if numeric.IsFloat[T]() {
+		var sum float64
+		sum, wraps = numeric.AddModFloat(float64(current), float64(incoming), float64(t.modulus))
+		current = T(sum)
+	} else {
+		// State outside [0, modulus) after SetState or a reset: bring it back in range
+		count := uint64(max(current, 0))
+		if t.modulus != 0 {
+			count %= t.modulus
+		}
+		var sum uint64
+		sum, wraps = numeric.AddMod(count, uint64(incoming), t.modulus)
+		current = T(sum)
+	}
+	t.wraps.Add(wraps)
+	return current
+}
+
+// Name returns the transform identifier.
+func (t *WrapAccumulate[T]) Name() string {
+	return "WrapAccumulate"
+}
+
+// Wraps returns the number of times the total wrapped.
+func (t *WrapAccumulate[T]) Wraps() uint64 {
+	return t.wraps.Load()
+}
@@ -1,8 +1,23 @@
 package value
 
+import "github.com/neox5/simv/clock"
+
 // UpdateHook receives notifications during value update cycles.
 type UpdateHook[T any] interface {
 	OnInput(input T, state T)
 	OnTransform(name string, input T, output T, state T)
 	AfterUpdate(finalState T)
 }
+
+// SkipHook is optionally implemented by an UpdateHook to be notified when
+// a transform.Filter vetoes an update. OnSkip replaces AfterUpdate for
+// that cycle.
+type SkipHook[T any] interface {
+	OnSkip(name string, input T, state T)
+}
+
+// TickHook is optionally implemented by an UpdateHook to receive the tick
+// that produced each sample. OnTick is called right before OnInput.
+type TickHook interface {
+	OnTick(tick clock.Tick)
+}
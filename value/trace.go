@@ -5,14 +5,21 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/neox5/simv/clock"
 )
 
 // TraceEvent captures a complete value update cycle.
+// Timestamp is the scheduled time of the originating tick, so traces follow
+// the simulated timeline rather than the time the event was processed.
+// Samples without a scheduled time are stamped when they are received.
 type TraceEvent[T any] struct {
 	Timestamp  time.Time
+	Tick       clock.Tick          // zero value if direct SetState
 	Input      T                   // zero value if direct SetState
 	Transforms []TransformTrace[T] // empty if direct SetState
 	FinalState T
+	SkippedBy  string // name of the filter that vetoed the update, empty otherwise
 }
 
 // TransformTrace captures a single transform application.
@@ -29,7 +36,9 @@ type TraceHook[T any] struct {
 
 	// Accumulates data during update cycle
 	mu         sync.Mutex
+	hasInput   bool // OnInput was called in this cycle, false for direct SetState
 	timestamp  time.Time
+	tick       clock.Tick
 	input      T
 	transforms []TransformTrace[T]
 }
@@ -48,11 +57,24 @@ func NewDefaultTraceHook[T any]() *TraceHook[T] {
 	})
 }
 
+// OnTick records the tick of the upcoming input.
+// Implements TickHook.
+func (h *TraceHook[T]) OnTick(tick clock.Tick) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.tick = tick
+}
+
 func (h *TraceHook[T]) OnInput(input T, state T) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	h.timestamp = time.Now()
+	h.hasInput = true
+	h.timestamp = h.tick.Scheduled
+	if h.timestamp.IsZero() {
+		h.timestamp = time.Now()
+	}
 	h.input = input
 	h.transforms = h.transforms[:0] // reset
 }
@@ -73,10 +95,11 @@ func (h *TraceHook[T]) AfterUpdate(finalState T) {
 	h.mu.Lock()
 
 	// Check if this is a direct SetState call (no OnInput)
-	hasInput := !h.timestamp.IsZero()
+	hasInput := h.hasInput
 
 	event := TraceEvent[T]{
 		Timestamp:  h.timestamp,
+		Tick:       h.tick,
 		Input:      h.input,
 		Transforms: append([]TransformTrace[T](nil), h.transforms...),
 		FinalState: finalState,
@@ -85,13 +108,16 @@ func (h *TraceHook[T]) AfterUpdate(finalState T) {
 	if !hasInput {
 		// Direct SetState, set timestamp now
 		event.Timestamp = time.Now()
-		// Clear input and transforms for SetState-only events
+		// Clear tick, input and transforms for SetState-only events
+		event.Tick = clock.Tick{}
 		event.Input = *new(T) // zero value
 		event.Transforms = nil
 	}
 
 	// Reset for next cycle
+	h.hasInput = false
 	h.timestamp = time.Time{}
+	h.tick = clock.Tick{}
 	h.input = *new(T)               // Clear input
 	h.transforms = h.transforms[:0] // Clear transforms
 
@@ -100,10 +126,47 @@ func (h *TraceHook[T]) AfterUpdate(finalState T) {
 	h.callback(event)
 }
 
+// OnSkip emits an event for an update vetoed by a filter.
+// FinalState is the unchanged state.
+func (h *TraceHook[T]) OnSkip(name string, input T, state T) {
+	h.mu.Lock()
+
+	event := TraceEvent[T]{
+		Timestamp:  h.timestamp,
+		Tick:       h.tick,
+		Input:      h.input,
+		Transforms: append([]TransformTrace[T](nil), h.transforms...),
+		FinalState: state,
+		SkippedBy:  name,
+	}
+
+	// Reset for next cycle
+	h.hasInput = false
+	h.timestamp = time.Time{}
+	h.tick = clock.Tick{}
+	h.input = *new(T)
+	h.transforms = h.transforms[:0]
+
+	h.mu.Unlock()
+
+	h.callback(event)
+}
+
 // FormatTraceLine formats a trace event as a single pipe-separated line.
 func FormatTraceLine[T any](evt TraceEvent[T]) string {
 	timestamp := evt.Timestamp.Format("15:04:05.000")
 
+	if evt.SkippedBy != "" {
+		// Vetoed update: input | Transform(s:state) | output | ... | Filter | skipped
+		parts := []string{fmt.Sprintf("%v", evt.Input)}
+		for _, tr := range evt.Transforms {
+			parts = append(parts, fmt.Sprintf("%s(s:%v)", tr.Name, tr.State), fmt.Sprintf("%v", tr.Output))
+		}
+		parts = append(parts, evt.SkippedBy, "skipped")
+
+		return fmt.Sprintf("[%s] %s", timestamp, strings.Join(parts, " | "))
+	}
+
 	if len(evt.Transforms) > 0 {
 		// Normal update: input | Transform(s:state) | output | ...
 		var parts []string
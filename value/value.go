@@ -4,12 +4,24 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/neox5/simv/clock"
+	"github.com/neox5/simv/source"
 	"github.com/neox5/simv/transform"
 )
 
-// Publisher provides a subscription interface for typed values.
+// Publisher provides a subscription interface for typed samples.
 type Publisher[T any] interface {
-	Subscribe() <-chan T
+	Subscribe() <-chan source.Sample[T]
+}
+
+// deliveryPublisher is a Publisher that supports per-subscription delivery policies.
+type deliveryPublisher[T any] interface {
+	SubscribeWith(d source.Delivery) <-chan source.Sample[T]
+}
+
+// unsubscriber is a Publisher that can end a subscription early.
+type unsubscriber[T any] interface {
+	Unsubscribe(ch <-chan source.Sample[T])
 }
 
 // ValueStats contains observable metrics for a Value.
@@ -17,6 +29,8 @@ type ValueStats[T any] struct {
 	UpdateCount    uint64
 	CurrentValue   T
 	TransformCount int
+	LastTick       clock.Tick // tick of the most recent update, zero before the first
+	SkipCount      uint64     // samples vetoed by a transform.Filter
 }
 
 // Value represents a thread-safe simulated value with configurable behavior.
@@ -30,8 +44,11 @@ type Value[T any] struct {
 	resetOnRead bool
 	resetValue  T
 
+	// Subscription delivery policy (nil uses Subscribe)
+	delivery *source.Delivery
+
 	// Lifecycle
-	sourceChan <-chan T
+	sourceChan <-chan source.Sample[T]
 	started    atomic.Bool
 	stopOnce   sync.Once
 	done       chan struct{}
@@ -39,7 +56,10 @@ type Value[T any] struct {
 	// State (mutable, protected by mu)
 	mu          sync.RWMutex
 	current     T
+	tick        clock.Tick // tick of the sample being processed
+	lastTick    clock.Tick
 	updateCount atomic.Uint64
+	skipCount   atomic.Uint64
 
 	// Observability
 	updateHook atomic.Value // stores UpdateHook[T]
@@ -77,6 +97,21 @@ func (v *Value[T]) EnableResetOnRead(resetValue T) *Value[T] {
 	return v
 }
 
+// SetDelivery configures how the source delivers samples to this value,
+// e.g. to drop samples instead of stalling the source when updates are slow.
+// Returns the value for method chaining.
+// Panics if called after Start() or if the source does not support SubscribeWith.
+func (v *Value[T]) SetDelivery(d source.Delivery) *Value[T] {
+	if v.started.Load() {
+		panic("cannot set delivery after Start()")
+	}
+	if _, ok := v.source.(deliveryPublisher[T]); !ok {
+		panic("source does not support delivery policies")
+	}
+	v.delivery = &d
+	return v
+}
+
 // SetUpdateHook sets the update hook for this value.
 // Pass nil to disable hook.
 // Can be called before or after Start().
@@ -90,23 +125,39 @@ func (v *Value[T]) SetUpdateHook(hook UpdateHook[T]) *Value[T] {
 }
 
 // Start begins receiving updates from the source.
-// Locks configuration - no further AddTransform or EnableResetOnRead calls allowed.
+// Locks configuration - no further AddTransform, EnableResetOnRead or SetDelivery calls allowed.
 // Returns the value for method chaining.
 // Panics if already started.
 func (v *Value[T]) Start() *Value[T] {
 	if !v.started.CompareAndSwap(false, true) {
 		panic("already started")
 	}
-	v.sourceChan = v.source.Subscribe()
+	v.sourceChan = v.subscribe()
 	go v.run()
 	return v
 }
 
+// subscribe subscribes to the source using the configured delivery policy.
+func (v *Value[T]) subscribe() <-chan source.Sample[T] {
+	if v.delivery == nil {
+		return v.source.Subscribe()
+	}
+	return v.source.(deliveryPublisher[T]).SubscribeWith(*v.delivery)
+}
+
 // Stop stops receiving updates and releases resources.
+// If the source supports Unsubscribe the subscription ends immediately,
+// otherwise Stop waits for the source to close (e.g. when its clock stops).
 // Blocks until the update goroutine exits.
-// Safe to call multiple times.
+// Safe to call multiple times. No-op if the value was never started.
 func (v *Value[T]) Stop() {
+	if !v.started.Load() {
+		return
+	}
 	v.stopOnce.Do(func() {
+		if src, ok := v.source.(unsubscriber[T]); ok {
+			src.Unsubscribe(v.sourceChan)
+		}
 		// Wait for run() to finish and close done channel
 		<-v.done
 	})
@@ -138,6 +189,8 @@ func (v *Value[T]) Stats() ValueStats[T] {
 		UpdateCount:    v.updateCount.Load(),
 		CurrentValue:   v.current,
 		TransformCount: len(v.transforms),
+		LastTick:       v.lastTick,
+		SkipCount:      v.skipCount.Load(),
 	}
 }
 
@@ -148,6 +201,13 @@ func (v *Value[T]) GetState() T {
 	return v.current
 }
 
+// Tick returns the tick of the sample being processed.
+// Implements transform.State[T].
+// Must be called with lock held (from within run()).
+func (v *Value[T]) Tick() clock.Tick {
+	return v.tick
+}
+
 // run processes incoming values from the source.
 // Runs in its own goroutine, started by Start().
 func (v *Value[T]) run() {
@@ -159,38 +219,76 @@ func (v *Value[T]) run() {
 		}
 	}()
 
-	for sourceValue := range v.sourceChan {
-		v.mu.Lock()
+	for sample := range v.sourceChan {
+		v.update(sample)
+	}
+}
 
-		hook := v.getUpdateHook()
+// update runs a single sample through the transform chain and stores the result.
+// A transform.Filter vetoing the sample ends the update without changing state.
+func (v *Value[T]) update(sample source.Sample[T]) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
 
-		// Notify: input received
-		if hook != nil {
-			v.safeHookCall(func() { hook.OnInput(sourceValue, v.current) })
-		}
+	v.tick = sample.Tick
+	hook := v.getUpdateHook()
+
+	// Notify: input received
+	if h, ok := hook.(TickHook); ok {
+		v.safeHookCall(func() { h.OnTick(sample.Tick) })
+	}
+	if hook != nil {
+		v.safeHookCall(func() { hook.OnInput(sample.Value, v.current) })
+	}
 
-		// Apply transforms with notifications
-		transformed := sourceValue
-		for _, t := range v.transforms {
-			input := transformed
-			currentState := v.current
+	// Apply transforms with notifications
+	transformed, kept := v.applyAll(v.transforms, "", sample.Value, hook)
+	if !kept {
+		return
+	}
 
-			transformed = t.Apply(transformed, v)
+	// Update state
+	v.lastTick = sample.Tick
+	v.setState(transformed)
+	v.updateCount.Add(1)
+}
 
-			if hook != nil {
-				name := t.Name()
-				v.safeHookCall(func() {
-					hook.OnTransform(name, input, transformed, currentState)
-				})
+// applyAll runs value through steps in order, expanding transform.Composite
+// steps in place with their name as prefix.
+// Returns false if a transform.Filter vetoed the update.
+// Must be called with v.mu held (locked).
+func (v *Value[T]) applyAll(steps []transform.Transformation[T], prefix string, value T, hook UpdateHook[T]) (T, bool) {
+	for _, t := range steps {
+		name := prefix + t.Name()
+		input := value
+		currentState := v.current
+
+		if c, ok := t.(transform.Composite[T]); ok {
+			var kept bool
+			if value, kept = v.applyAll(c.Steps(value, v), name+"/", value, hook); !kept {
+				return value, false
 			}
+			continue
 		}
 
-		// Update state
-		v.setState(transformed)
-		v.updateCount.Add(1)
+		if f, ok := t.(transform.Filter[T]); ok && !f.Keep(value, v) {
+			v.skipCount.Add(1)
+			if h, ok := hook.(SkipHook[T]); ok {
+				v.safeHookCall(func() { h.OnSkip(name, input, currentState) })
+			}
+			return value, false
+		}
+
+		value = t.Apply(value, v)
 
-		v.mu.Unlock()
+		if hook != nil {
+			output := value
+			v.safeHookCall(func() {
+				hook.OnTransform(name, input, output, currentState)
+			})
+		}
 	}
+	return value, true
 }
 
 // setState updates the internal state and triggers AfterUpdate hook.
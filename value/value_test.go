@@ -0,0 +1,183 @@
+package value_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neox5/simv/histogram"
+	"github.com/neox5/simv/source"
+	"github.com/neox5/simv/transform"
+	"github.com/neox5/simv/value"
+)
+
+// TestValue_FilterSkipsUpdate verifies that a vetoed sample leaves state and
+// UpdateCount unchanged and is reported through SkipHook.
+func TestValue_FilterSkipsUpdate(t *testing.T) {
+	input := make(chan int)
+	var events []value.TraceEvent[int]
+
+	val := value.New[int](source.FromChannel(input)).
+		AddTransform(transform.NewThreshold(0, 100)).
+		AddTransform(transform.NewAccumulate[int]()).
+		SetUpdateHook(value.NewTraceHook(func(evt value.TraceEvent[int]) {
+			events = append(events, evt)
+		})).
+		Start()
+
+	for _, v := range []int{5, 500, 7} {
+		input <- v
+	}
+	close(input)
+
+	waitForSamples(t, val, 3)
+	val.Stop()
+
+	stats := val.Stats()
+	if stats.CurrentValue != 12 || stats.UpdateCount != 2 || stats.SkipCount != 1 {
+		t.Errorf("stats = %+v, want current 12, 2 updates, 1 skip", stats)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("got %d trace events, want 3", len(events))
+	}
+	if skipped := events[1]; skipped.SkippedBy != "Threshold" || skipped.Input != 500 || skipped.FinalState != 5 {
+		t.Errorf("skip event = %+v, want Threshold veto of 500 with state 5", skipped)
+	}
+}
+
+// TestValue_PipelineReportsSteps verifies that composite steps are applied
+// and reported individually, and that filters inside them veto the update.
+func TestValue_PipelineReportsSteps(t *testing.T) {
+	input := make(chan int)
+	var events []value.TraceEvent[int]
+
+	shape := transform.NewPipeline("Shape",
+		transform.If(transform.NewThreshold(0, 10), transform.NewScale[int](10, 0), nil),
+		transform.NewThreshold(0, 100),
+	)
+	val := value.New[int](source.FromChannel(input)).
+		AddTransform(shape).
+		SetUpdateHook(value.NewTraceHook(func(evt value.TraceEvent[int]) {
+			events = append(events, evt)
+		})).
+		Start()
+
+	for _, v := range []int{2, 50, 500} {
+		input <- v
+	}
+	close(input)
+	waitForSamples(t, val, 3)
+	val.Stop()
+
+	if stats := val.Stats(); stats.CurrentValue != 50 || stats.UpdateCount != 2 || stats.SkipCount != 1 {
+		t.Errorf("stats = %+v, want current 50, 2 updates, 1 skip", stats)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("got %d trace events, want 3", len(events))
+	}
+	wantSteps := [][]string{
+		{"Shape/If(Threshold)/Scale", "Shape/Threshold"},
+		{"Shape/Threshold"},
+		nil,
+	}
+	for i, evt := range events {
+		var steps []string
+		for _, tr := range evt.Transforms {
+			steps = append(steps, tr.Name)
+		}
+		if !slices.Equal(steps, wantSteps[i]) {
+			t.Errorf("event %d steps = %v, want %v", i, steps, wantSteps[i])
+		}
+	}
+	if got := events[2].SkippedBy; got != "Shape/Threshold" {
+		t.Errorf("SkippedBy = %q, want %q", got, "Shape/Threshold")
+	}
+}
+
+// TestValue_DeltaHistogram verifies bucketing of observations and that
+// reset-on-read yields delta histograms.
+func TestValue_DeltaHistogram(t *testing.T) {
+	input := make(chan float64)
+	defer close(input)
+
+	val := value.New[histogram.Histogram](source.Map(source.FromChannel(input), histogram.Observation)).
+		AddTransform(transform.NewBucket(1, 5)).
+		EnableResetOnRead(histogram.Histogram{}).
+		Start()
+	defer val.Stop()
+
+	for _, v := range []float64{0.5, 3, 10} {
+		input <- v
+	}
+	waitForSamples(t, val, 3)
+
+	h := val.Value()
+	if !slices.Equal(h.Counts, []uint64{1, 1, 1}) || h.Sum != 13.5 || h.Count != 3 {
+		t.Errorf("histogram = %+v, want counts [1 1 1], sum 13.5, count 3", h)
+	}
+	if got := h.Cumulative(); !slices.Equal(got, []uint64{1, 2, 3}) {
+		t.Errorf("Cumulative() = %v, want [1 2 3]", got)
+	}
+
+	input <- 5
+	waitForSamples(t, val, 4)
+
+	if h := val.Value(); !slices.Equal(h.Counts, []uint64{0, 1, 0}) || h.Sum != 5 || h.Count != 1 {
+		t.Errorf("delta histogram = %+v, want counts [0 1 0], sum 5, count 1", h)
+	}
+}
+
+// chanPublisher is a minimal user-defined value.Publisher whose samples
+// carry no tick.
+type chanPublisher[T any] struct {
+	ch chan source.Sample[T]
+}
+
+func (p *chanPublisher[T]) Subscribe() <-chan source.Sample[T] { return p.ch }
+
+// TestValue_TraceWithoutTick verifies that samples with a zero tick are
+// traced as regular updates, not as direct SetState calls.
+func TestValue_TraceWithoutTick(t *testing.T) {
+	pub := &chanPublisher[int]{ch: make(chan source.Sample[int])}
+	var events []value.TraceEvent[int]
+
+	val := value.New[int](pub).
+		AddTransform(transform.NewAccumulate[int]()).
+		SetUpdateHook(value.NewTraceHook(func(evt value.TraceEvent[int]) {
+			events = append(events, evt)
+		})).
+		Start()
+
+	pub.ch <- source.Sample[int]{Value: 3}
+	pub.ch <- source.Sample[int]{Value: 4}
+	close(pub.ch)
+	waitForSamples(t, val, 2)
+	val.Stop()
+
+	if len(events) != 2 {
+		t.Fatalf("got %d trace events, want 2", len(events))
+	}
+	for i, evt := range events {
+		if evt.Input == 0 || len(evt.Transforms) != 1 || evt.Timestamp.IsZero() {
+			t.Errorf("event %d = %+v, want input, one transform and a timestamp", i, evt)
+		}
+	}
+	if got := events[1].FinalState; got != 7 {
+		t.Errorf("FinalState = %d, want 7", got)
+	}
+}
+
+// waitForSamples waits until val processed n samples. Stop unsubscribes
+// immediately, so tests wait before stopping to not lose in-flight samples.
+func waitForSamples[T any](t *testing.T, val *value.Value[T], n uint64) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for stats := val.Stats(); stats.UpdateCount+stats.SkipCount < n; stats = val.Stats() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %d samples, stats = %+v", n, stats)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}